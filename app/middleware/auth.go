@@ -1,10 +1,17 @@
 package middleware
 
 import (
+	"errors"
+	"net/http"
+
 	"github.com/BearTS/go-gin-monolith/constants"
+	"github.com/BearTS/go-gin-monolith/database"
+	"github.com/BearTS/go-gin-monolith/dbops/gorm/guests"
+	"github.com/BearTS/go-gin-monolith/dbops/gorm/users"
 	"github.com/BearTS/go-gin-monolith/services/authsvc"
 	"github.com/BearTS/go-gin-monolith/utils"
 	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
 )
 
 func TokenAuth() gin.HandlerFunc {
@@ -16,17 +23,81 @@ func TokenAuth() gin.HandlerFunc {
 			return
 		}
 		// Remove the Bearer prefix and take the token
-		tokenHandler := authsvc.Handler()
-		err := tokenHandler.ValidateToken(token)
+		err := authsvc.ValidateToken(token)
+		if err != nil {
+			ctx.JSON(401, gin.H{"error": err.Error()})
+			ctx.Abort()
+			return
+		}
+
+		gormDB, _ := database.Connection()
+		status, err := checkNotRevoked(ctx, users.Gorm(gormDB), guests.Gorm(gormDB))
+		if err != nil {
+			ctx.JSON(status, gin.H{"error": err.Error()})
+			ctx.Abort()
+			return
+		}
+		ctx.Next()
+	}
+}
+
+// RefreshTokenAuth lets through a valid, unexpired refresh token. Access tokens
+// are signed with another secret and fail here, as refresh tokens do in TokenAuth.
+func RefreshTokenAuth() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		if ctx.GetHeader("Authorization") == "" {
+			ctx.JSON(401, gin.H{"error": "request does not contain a refresh token"})
+			ctx.Abort()
+			return
+		}
+
+		refreshData, err := utils.GetRefreshTokenData(ctx)
 		if err != nil {
 			ctx.JSON(401, gin.H{"error": err.Error()})
 			ctx.Abort()
 			return
 		}
+		if refreshData.Type != "refresh" {
+			ctx.JSON(401, gin.H{"error": "not a refresh token"})
+			ctx.Abort()
+			return
+		}
 		ctx.Next()
 	}
 }
 
+// checkNotRevoked cuts off suspended users and revoked guests straight away,
+// rather than when their access token expires
+func checkNotRevoked(ctx *gin.Context, usersGorm users.GormInterface, guestsGorm guests.GormInterface) (int, error) {
+	authData, err := utils.GetAuthData(ctx)
+	if err != nil {
+		return http.StatusUnauthorized, err
+	}
+
+	switch authData.Type {
+	case constants.TokenTypes.USER:
+		user, err := usersGorm.GetUserDetailsByPID(ctx, authData.UserPID)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return http.StatusUnauthorized, errors.New("user not found")
+		}
+		if err != nil {
+			return http.StatusInternalServerError, errors.New("could not verify user")
+		}
+		if user.IsSuspended {
+			return http.StatusForbidden, errors.New("user is suspended")
+		}
+	case constants.TokenTypes.GUEST:
+		_, err := guestsGorm.GetGuestByPID(ctx, authData.GuestPID)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return http.StatusUnauthorized, errors.New("guest access has been revoked")
+		}
+		if err != nil {
+			return http.StatusInternalServerError, errors.New("could not verify guest")
+		}
+	}
+	return http.StatusOK, nil
+}
+
 func CheckIfCustomer() gin.HandlerFunc {
 	return func(ctx *gin.Context) {
 		authData, err := utils.GetAuthData(ctx)
@@ -65,3 +136,24 @@ func CheckIfAdmin() gin.HandlerFunc {
 		ctx.Next()
 	}
 }
+
+// CheckIfUserOrGuest lets customers and room guests through, but not admins
+func CheckIfUserOrGuest() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		authData, err := utils.GetAuthData(ctx)
+		if err != nil {
+			ctx.JSON(401, gin.H{"error": err.Error()})
+			ctx.Abort()
+			return
+		}
+
+		switch authData.Type {
+		case constants.TokenTypes.USER, constants.TokenTypes.GUEST:
+			ctx.Next()
+		default:
+			ctx.JSON(401, gin.H{"error": "user is not a customer or guest"})
+			ctx.Abort()
+			return
+		}
+	}
+}
@@ -0,0 +1,111 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/BearTS/go-gin-monolith/config"
+	"github.com/BearTS/go-gin-monolith/constants"
+	"github.com/BearTS/go-gin-monolith/database/tables"
+	"github.com/BearTS/go-gin-monolith/dbops/gorm/guests"
+	"github.com/BearTS/go-gin-monolith/dbops/gorm/users"
+	"github.com/BearTS/go-gin-monolith/models"
+	"github.com/BearTS/go-gin-monolith/services/authsvc"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+type fakeUsersGorm struct {
+	users.GormInterface
+	users map[string]tables.Users
+}
+
+func (f *fakeUsersGorm) GetUserDetailsByPID(ctx *gin.Context, pid string) (tables.Users, error) {
+	user, ok := f.users[pid]
+	if !ok {
+		return tables.Users{}, gorm.ErrRecordNotFound
+	}
+	return user, nil
+}
+
+type fakeGuestsGorm struct {
+	guests.GormInterface
+	guests map[string]tables.Guests
+}
+
+func (f *fakeGuestsGorm) GetGuestByPID(ctx *gin.Context, pid string) (tables.Guests, error) {
+	guest, ok := f.guests[pid]
+	if !ok || guest.IsDeleted {
+		return tables.Guests{}, gorm.ErrRecordNotFound
+	}
+	return guest, nil
+}
+
+func TestCheckNotRevoked(t *testing.T) {
+	config.Token = &config.TokenConfig{AccessSecret: "test-access", RefreshSecret: "test-refresh"}
+
+	usersGorm := &fakeUsersGorm{users: map[string]tables.Users{
+		"usr_ok":        {PID: "usr_ok"},
+		"usr_suspended": {PID: "usr_suspended", IsSuspended: true},
+	}}
+	guestsGorm := &fakeGuestsGorm{guests: map[string]tables.Guests{
+		"gst_ok":      {PID: "gst_ok"},
+		"gst_revoked": {PID: "gst_revoked", IsDeleted: true},
+	}}
+
+	cases := []struct {
+		authData models.AuthData
+		status   int
+	}{
+		{models.AuthData{Type: constants.TokenTypes.USER, UserPID: "usr_ok"}, http.StatusOK},
+		{models.AuthData{Type: constants.TokenTypes.USER, UserPID: "usr_suspended"}, http.StatusForbidden},
+		{models.AuthData{Type: constants.TokenTypes.USER, UserPID: "usr_gone"}, http.StatusUnauthorized},
+		{models.AuthData{Type: constants.TokenTypes.GUEST, GuestPID: "gst_ok", RoomPID: "rom_1"}, http.StatusOK},
+		{models.AuthData{Type: constants.TokenTypes.GUEST, GuestPID: "gst_revoked", RoomPID: "rom_1"}, http.StatusUnauthorized},
+	}
+	for _, tc := range cases {
+		td, err := authsvc.Handler(nil, nil).CreateToken(tc.authData)
+		require.NoError(t, err)
+
+		ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+		ctx.Request = httptest.NewRequest(http.MethodGet, "/", nil)
+		ctx.Request.Header.Set("Authorization", td.AccessToken)
+
+		status, _ := checkNotRevoked(ctx, usersGorm, guestsGorm)
+		assert.Equal(t, tc.status, status, tc.authData.UserPID+tc.authData.GuestPID)
+	}
+}
+
+func TestRefreshTokenAuth(t *testing.T) {
+	config.Token = &config.TokenConfig{AccessSecret: "test-access", RefreshSecret: "test-refresh"}
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.POST("/", RefreshTokenAuth(), func(ctx *gin.Context) {
+		ctx.Status(http.StatusNoContent)
+	})
+
+	td, err := authsvc.Handler(nil, nil).CreateToken(models.AuthData{Type: constants.TokenTypes.USER, UserPID: "usr_ok"})
+	require.NoError(t, err)
+
+	cases := []struct {
+		token  string
+		status int
+	}{
+		{td.RefreshToken, http.StatusNoContent},
+		{td.AccessToken, http.StatusUnauthorized},
+		{"", http.StatusUnauthorized},
+	}
+	for _, tc := range cases {
+		req := httptest.NewRequest(http.MethodPost, "/", nil)
+		if tc.token != "" {
+			req.Header.Set("Authorization", tc.token)
+		}
+		recorder := httptest.NewRecorder()
+		router.ServeHTTP(recorder, req)
+
+		assert.Equal(t, tc.status, recorder.Code)
+	}
+}
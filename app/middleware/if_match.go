@@ -0,0 +1,30 @@
+package middleware
+
+import (
+	"errors"
+
+	"github.com/BearTS/go-gin-monolith/merrors"
+	"github.com/BearTS/go-gin-monolith/utils"
+	"github.com/gin-gonic/gin"
+)
+
+// RequireIfMatch guards mutating routes of versioned resources, handlers can
+// then read the version with utils.GetIfMatchVersion
+func RequireIfMatch() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		_, err := utils.GetIfMatchVersion(ctx)
+		switch {
+		case errors.Is(err, utils.ErrIfMatchMissing):
+			merrors.PreconditionRequired(ctx, err.Error())
+			return
+		case errors.Is(err, utils.ErrIfMatchWeak):
+			merrors.PreconditionFailed(ctx, err.Error(), nil)
+			return
+		case err != nil:
+			merrors.Validation(ctx, err.Error())
+			return
+		}
+
+		ctx.Next()
+	}
+}
@@ -0,0 +1,39 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRequireIfMatch(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.PATCH("/", RequireIfMatch(), func(ctx *gin.Context) {
+		ctx.Status(http.StatusNoContent)
+	})
+
+	cases := []struct {
+		header string
+		status int
+	}{
+		{`"3"`, http.StatusNoContent},
+		{`*`, http.StatusNoContent},
+		{``, http.StatusPreconditionRequired},
+		{`W/"3"`, http.StatusPreconditionFailed},
+		{`3`, http.StatusUnprocessableEntity},
+	}
+	for _, tc := range cases {
+		req := httptest.NewRequest(http.MethodPatch, "/", nil)
+		if tc.header != "" {
+			req.Header.Set("If-Match", tc.header)
+		}
+		recorder := httptest.NewRecorder()
+		router.ServeHTTP(recorder, req)
+
+		assert.Equal(t, tc.status, recorder.Code, tc.header)
+	}
+}
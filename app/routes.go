@@ -1,13 +1,32 @@
 package app
 
 import (
+	"log"
+
 	"github.com/BearTS/go-gin-monolith/app/middleware"
+	"github.com/BearTS/go-gin-monolith/controllers/v1/admin"
+	"github.com/BearTS/go-gin-monolith/controllers/v1/catalog"
+	"github.com/BearTS/go-gin-monolith/controllers/v1/report"
+	"github.com/BearTS/go-gin-monolith/controllers/v1/search"
 	"github.com/BearTS/go-gin-monolith/controllers/v1/user"
 	"github.com/BearTS/go-gin-monolith/database"
+	"github.com/BearTS/go-gin-monolith/dbops/gorm/albums"
+	"github.com/BearTS/go-gin-monolith/dbops/gorm/artists"
+	"github.com/BearTS/go-gin-monolith/dbops/gorm/audit_logs"
+	"github.com/BearTS/go-gin-monolith/dbops/gorm/guests"
+	"github.com/BearTS/go-gin-monolith/dbops/gorm/moderation_flags"
 	"github.com/BearTS/go-gin-monolith/dbops/gorm/otp_verifications"
+	"github.com/BearTS/go-gin-monolith/dbops/gorm/reports"
+	"github.com/BearTS/go-gin-monolith/dbops/gorm/tracks"
 	"github.com/BearTS/go-gin-monolith/dbops/gorm/users"
+	"github.com/BearTS/go-gin-monolith/redis"
 	"github.com/BearTS/go-gin-monolith/services/authsvc"
+	"github.com/BearTS/go-gin-monolith/services/catalogsvc"
+	"github.com/BearTS/go-gin-monolith/services/moderationsvc"
+	"github.com/BearTS/go-gin-monolith/services/reportsvc"
+	"github.com/BearTS/go-gin-monolith/services/searchsvc"
 	"github.com/BearTS/go-gin-monolith/services/usersvc"
+	"github.com/BearTS/go-gin-monolith/spotify"
 	"github.com/gin-gonic/gin"
 )
 
@@ -19,12 +38,34 @@ func MapURL() {
 	gormDB, _ := database.Connection()
 	usersGorm := users.Gorm(gormDB)
 	otpVerificationsGorm := otp_verifications.Gorm(gormDB)
+	guestsGorm := guests.Gorm(gormDB)
+	moderationFlagsGorm := moderation_flags.Gorm(gormDB)
+	reportsGorm := reports.Gorm(gormDB)
+	auditLogsGorm := audit_logs.Gorm(gormDB)
+	tracksGorm := tracks.Gorm(gormDB)
+	artistsGorm := artists.Gorm(gormDB)
+	albumsGorm := albums.Gorm(gormDB)
+
+	var redisConn redis.Connection
+	if err := redisConn.NewConnection(); err != nil {
+		log.Println("[MapURL] redis is not reachable, search results won't be cached: ", err)
+	}
+
+	spotifyClient := spotify.NewClient()
 
-	authsvc := authsvc.Handler()
-	userSvc := usersvc.Handler(usersGorm, otpVerificationsGorm, authsvc)
+	moderationSvc := moderationsvc.Handler(moderationFlagsGorm, auditLogsGorm)
+	authsvc := authsvc.Handler(guestsGorm, moderationSvc)
+	userSvc := usersvc.Handler(usersGorm, otpVerificationsGorm, guestsGorm, authsvc)
+	reportSvc := reportsvc.Handler(reportsGorm, auditLogsGorm, usersGorm, guestsGorm)
+	searchSvc := searchsvc.Handler(spotifyClient, &redisConn)
+	catalogSvc := catalogsvc.Handler(tracksGorm, artistsGorm, albumsGorm, spotifyClient)
 
 	// Handlers
 	userHandler := user.Handler(userSvc)
+	reportHandler := report.Handler(reportSvc)
+	searchHandler := search.Handler(searchSvc)
+	catalogHandler := catalog.Handler(catalogSvc)
+	adminHandler := admin.Handler(moderationSvc, reportSvc)
 
 	v1 := router.Group("/v1")
 
@@ -33,6 +74,33 @@ func MapURL() {
 		users.POST("/send-otp", userHandler.SendOTP)
 		users.POST("/verify-otp", userHandler.VerifyOTP)
 		users.POST("/resend-otp", userHandler.ResendOTP)
+		users.POST("/refresh-token", middleware.RefreshTokenAuth(), userHandler.RefreshToken)
+	}
+
+	reports := v1.Group("/reports", middleware.TokenAuth())
+	{
+		reports.POST("", reportHandler.CreateReport)
+	}
+
+	v1.GET("/search", middleware.TokenAuth(), middleware.CheckIfUserOrGuest(), searchHandler.Search)
+
+	catalog := v1.Group("/catalog", middleware.TokenAuth(), middleware.CheckIfUserOrGuest())
+	{
+		catalog.GET("/tracks", catalogHandler.GetTracks)
+		catalog.GET("/artists", catalogHandler.GetArtists)
+		catalog.GET("/albums", catalogHandler.GetAlbums)
+	}
+
+	admin := v1.Group("/admin", middleware.TokenAuth(), middleware.CheckIfAdmin())
+	{
+		admin.GET("/moderation/flags", adminHandler.ListModerationFlags)
+		admin.POST("/moderation/flags/:pid/review", adminHandler.ReviewModerationFlag)
+
+		admin.GET("/reports", adminHandler.ListReports)
+		admin.GET("/reports/:pid", adminHandler.GetReport)
+		admin.POST("/reports/:pid/claim", adminHandler.ClaimReport)
+		admin.POST("/reports/:pid/resolve", adminHandler.ResolveReport)
+		admin.POST("/reports/:pid/dismiss", adminHandler.DismissReport)
 	}
 
 	err := router.Run()
@@ -23,4 +23,6 @@ func LoadConfigs() {
 	loadTokenConfig()
 	loadPasswordConfig()
 	loadFirebaseConfig()
+	loadModerationConfig()
+	loadSpotifyConfig()
 }
@@ -0,0 +1,21 @@
+package config
+
+import (
+	"log"
+
+	"github.com/kelseyhightower/envconfig"
+)
+
+type ModerationConfig struct {
+	WordList string `split_words:"true" json:"MODERATION_WORD_LIST"` // comma separated
+}
+
+var Moderation *ModerationConfig
+
+func loadModerationConfig() {
+	Moderation = &ModerationConfig{}
+	err := envconfig.Process("moderation", Moderation)
+	if err != nil {
+		log.Fatal(err.Error())
+	}
+}
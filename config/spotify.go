@@ -0,0 +1,24 @@
+package config
+
+import (
+	"log"
+
+	"github.com/kelseyhightower/envconfig"
+)
+
+type SpotifyConfig struct {
+	ClientID              string `split_words:"true" json:"SPOTIFY_CLIENT_ID"`
+	ClientSecret          string `split_words:"true" json:"SPOTIFY_CLIENT_SECRET"`
+	CatalogTtlMinutes     int    `split_words:"true" json:"SPOTIFY_CATALOG_TTL_MINUTES"`
+	SearchCacheTtlSeconds int    `split_words:"true" json:"SPOTIFY_SEARCH_CACHE_TTL_SECONDS"`
+}
+
+var Spotify *SpotifyConfig
+
+func loadSpotifyConfig() {
+	Spotify = &SpotifyConfig{}
+	err := envconfig.Process("spotify", Spotify)
+	if err != nil {
+		log.Fatal(err.Error())
+	}
+}
@@ -9,6 +9,7 @@ import (
 type TokenConfig struct {
 	AccessSecret  string `split_words:"true" json:"TOKEN_ACCESS_SECRET"`
 	RefreshSecret string `split_words:"true" json:"TOKEN_REFRESH_SECRET"`
+	GuestTtlHours int    `split_words:"true" json:"TOKEN_GUEST_TTL_HOURS"` // how long a guest may stay, defaults to 12
 }
 
 var Token *TokenConfig
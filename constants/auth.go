@@ -3,7 +3,9 @@ package constants
 var TokenTypes = struct {
 	USER  string
 	ADMIN string
+	GUEST string
 }{
 	USER:  "user",
 	ADMIN: "admin",
+	GUEST: "guest",
 }
@@ -0,0 +1,22 @@
+package constants
+
+// what a call site wants done with text that trips the moderation filters
+var ModerationActions = struct {
+	REJECT string
+	MASK   string
+	FLAG   string
+}{
+	REJECT: "reject",
+	MASK:   "mask",
+	FLAG:   "flag",
+}
+
+var ModerationFlagStatuses = struct {
+	PENDING  string
+	APPROVED string
+	REMOVED  string
+}{
+	PENDING:  "pending",
+	APPROVED: "approved",
+	REMOVED:  "removed",
+}
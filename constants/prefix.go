@@ -5,9 +5,17 @@ var Prefix = struct {
 	OTPVERIFICATION string
 	ADMIN           string
 	SESSION         string
+	GUEST           string
+	MODERATIONFLAG  string
+	REPORT          string
+	AUDITLOG        string
 }{
 	USER:            "usr",
 	OTPVERIFICATION: "otp",
 	ADMIN:           "adm",
 	SESSION:         "ses",
+	GUEST:           "gst",
+	MODERATIONFLAG:  "mfl",
+	REPORT:          "rpt",
+	AUDITLOG:        "aud",
 }
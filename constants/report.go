@@ -0,0 +1,60 @@
+package constants
+
+var ReportTargetTypes = struct {
+	MESSAGE string
+	MEMBER  string
+	ROOM    string
+}{
+	MESSAGE: "message",
+	MEMBER:  "member",
+	ROOM:    "room",
+}
+
+var ReportStatuses = struct {
+	OPEN      string
+	CLAIMED   string
+	RESOLVED  string
+	DISMISSED string
+}{
+	OPEN:      "open",
+	CLAIMED:   "claimed",
+	RESOLVED:  "resolved",
+	DISMISSED: "dismissed",
+}
+
+// what resolving a report does to its target, suspending a guest revokes them
+var ReportActions = struct {
+	NONE         string
+	SUSPEND_USER string
+}{
+	NONE:         "none",
+	SUSPEND_USER: "suspend_user",
+}
+
+var AuditActions = struct {
+	REPORT_CLAIMED           string
+	REPORT_RESOLVED          string
+	REPORT_DISMISSED         string
+	USER_SUSPENDED           string
+	GUEST_REVOKED            string
+	MODERATION_FLAG_REVIEWED string
+}{
+	REPORT_CLAIMED:           "report_claimed",
+	REPORT_RESOLVED:          "report_resolved",
+	REPORT_DISMISSED:         "report_dismissed",
+	USER_SUSPENDED:           "user_suspended",
+	GUEST_REVOKED:            "guest_revoked",
+	MODERATION_FLAG_REVIEWED: "moderation_flag_reviewed",
+}
+
+var AuditEntityTypes = struct {
+	REPORT          string
+	USER            string
+	GUEST           string
+	MODERATION_FLAG string
+}{
+	REPORT:          "report",
+	USER:            "user",
+	GUEST:           "guest",
+	MODERATION_FLAG: "moderation_flag",
+}
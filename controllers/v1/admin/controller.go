@@ -0,0 +1,192 @@
+package admin
+
+import (
+	"net/http"
+
+	"github.com/BearTS/go-gin-monolith/merrors"
+	"github.com/BearTS/go-gin-monolith/utils"
+	"github.com/gin-gonic/gin"
+)
+
+/* -------------------------------------------------------------------------- */
+/*                                 Moderation                                 */
+/* -------------------------------------------------------------------------- */
+
+/* ------------------------- List Moderation Flags -------------------------- */
+func (h *adminHandler) ListModerationFlags(c *gin.Context) {
+
+	req, err := validateListModerationFlagsReq(c)
+	if err != nil {
+		merrors.Validation(c, err.Error())
+		return
+	}
+
+	baseRes, res, err := h.moderationsvc.ListFlags(c, req)
+	if err != nil {
+		merrors.InternalServer(c, baseRes.Message)
+		return
+	}
+
+	if baseRes.StatusCode != http.StatusOK {
+		merrors.HandleServiceCodes(c, baseRes)
+		return
+	}
+
+	finalRes := listModerationFlagsTransformer(res)
+
+	utils.ReturnJSONStruct(c, finalRes)
+}
+
+/* ------------------------- Review Moderation Flag ------------------------- */
+func (h *adminHandler) ReviewModerationFlag(c *gin.Context) {
+
+	req, err := validateReviewModerationFlagReq(c)
+	if err != nil {
+		merrors.Validation(c, err.Error())
+		return
+	}
+
+	baseRes, res, err := h.moderationsvc.ReviewFlag(c, req)
+	if err != nil {
+		merrors.InternalServer(c, baseRes.Message)
+		return
+	}
+
+	if baseRes.StatusCode != http.StatusOK {
+		merrors.HandleServiceCodes(c, baseRes)
+		return
+	}
+
+	finalRes := reviewModerationFlagTransformer(res)
+
+	utils.ReturnJSONStruct(c, finalRes)
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                   Reports                                  */
+/* -------------------------------------------------------------------------- */
+
+/* ------------------------------ List Reports ------------------------------ */
+func (h *adminHandler) ListReports(c *gin.Context) {
+
+	req, err := validateListReportsReq(c)
+	if err != nil {
+		merrors.Validation(c, err.Error())
+		return
+	}
+
+	baseRes, res, err := h.reportsvc.ListReports(c, req)
+	if err != nil {
+		merrors.InternalServer(c, baseRes.Message)
+		return
+	}
+
+	if baseRes.StatusCode != http.StatusOK {
+		merrors.HandleServiceCodes(c, baseRes)
+		return
+	}
+
+	finalRes := listReportsTransformer(res)
+
+	utils.ReturnJSONStruct(c, finalRes)
+}
+
+/* ------------------------------- Get Report ------------------------------- */
+func (h *adminHandler) GetReport(c *gin.Context) {
+
+	reportPID, err := validateGetReportReq(c)
+	if err != nil {
+		merrors.Validation(c, err.Error())
+		return
+	}
+
+	baseRes, res, err := h.reportsvc.GetReport(c, reportPID)
+	if err != nil {
+		merrors.InternalServer(c, baseRes.Message)
+		return
+	}
+
+	if baseRes.StatusCode != http.StatusOK {
+		merrors.HandleServiceCodes(c, baseRes)
+		return
+	}
+
+	finalRes := reportDetailsTransformer(res)
+
+	utils.ReturnJSONStruct(c, finalRes)
+}
+
+/* ------------------------------ Claim Report ------------------------------ */
+func (h *adminHandler) ClaimReport(c *gin.Context) {
+
+	req, err := validateReportActionReq(c)
+	if err != nil {
+		merrors.Validation(c, err.Error())
+		return
+	}
+
+	baseRes, res, err := h.reportsvc.ClaimReport(c, req)
+	if err != nil {
+		merrors.InternalServer(c, baseRes.Message)
+		return
+	}
+
+	if baseRes.StatusCode != http.StatusOK {
+		merrors.HandleServiceCodes(c, baseRes)
+		return
+	}
+
+	finalRes := reportActionTransformer(baseRes, res)
+
+	utils.ReturnJSONStruct(c, finalRes)
+}
+
+/* ----------------------------- Resolve Report ----------------------------- */
+func (h *adminHandler) ResolveReport(c *gin.Context) {
+
+	req, err := validateReportActionReq(c)
+	if err != nil {
+		merrors.Validation(c, err.Error())
+		return
+	}
+
+	baseRes, res, err := h.reportsvc.ResolveReport(c, req)
+	if err != nil {
+		merrors.InternalServer(c, baseRes.Message)
+		return
+	}
+
+	if baseRes.StatusCode != http.StatusOK {
+		merrors.HandleServiceCodes(c, baseRes)
+		return
+	}
+
+	finalRes := reportActionTransformer(baseRes, res)
+
+	utils.ReturnJSONStruct(c, finalRes)
+}
+
+/* ----------------------------- Dismiss Report ----------------------------- */
+func (h *adminHandler) DismissReport(c *gin.Context) {
+
+	req, err := validateReportActionReq(c)
+	if err != nil {
+		merrors.Validation(c, err.Error())
+		return
+	}
+
+	baseRes, res, err := h.reportsvc.DismissReport(c, req)
+	if err != nil {
+		merrors.InternalServer(c, baseRes.Message)
+		return
+	}
+
+	if baseRes.StatusCode != http.StatusOK {
+		merrors.HandleServiceCodes(c, baseRes)
+		return
+	}
+
+	finalRes := reportActionTransformer(baseRes, res)
+
+	utils.ReturnJSONStruct(c, finalRes)
+}
@@ -0,0 +1,18 @@
+package admin
+
+import (
+	"github.com/BearTS/go-gin-monolith/services/moderationsvc"
+	"github.com/BearTS/go-gin-monolith/services/reportsvc"
+)
+
+type adminHandler struct {
+	moderationsvc moderationsvc.Interface
+	reportsvc     reportsvc.Interface
+}
+
+func Handler(moderationSvc moderationsvc.Interface, reportSvc reportsvc.Interface) *adminHandler {
+	return &adminHandler{
+		moderationsvc: moderationSvc,
+		reportsvc:     reportSvc,
+	}
+}
@@ -0,0 +1,129 @@
+package admin
+
+import (
+	"net/http"
+
+	"github.com/BearTS/go-gin-monolith/database/tables"
+	"github.com/BearTS/go-gin-monolith/services/moderationsvc"
+	"github.com/BearTS/go-gin-monolith/services/reportsvc"
+	"github.com/BearTS/go-gin-monolith/utils"
+)
+
+func moderationFlagTransformer(data tables.ModerationFlags) moderationsvc.ModerationFlagRes {
+	var res moderationsvc.ModerationFlagRes
+
+	res.FlagPID = data.PID
+	res.Source = data.Source
+	res.SourcePID = data.SourcePID
+	res.AuthorPID = data.AuthorPID
+	res.Text = data.Text
+	res.Matches = data.Matches
+	res.Status = data.Status
+	res.ReviewedBy = data.ReviewedBy
+	res.ReviewNotes = data.ReviewNotes
+	res.CreatedAt = data.CreatedAt
+
+	return res
+}
+
+func listModerationFlagsTransformer(data []tables.ModerationFlags) utils.BaseResponse {
+	var res utils.BaseResponse
+	dataRes := []moderationsvc.ModerationFlagRes{}
+
+	res.Success = true
+	res.StatusCode = http.StatusOK
+	res.Message = "moderation flags fetched successfully"
+
+	for _, flag := range data {
+		dataRes = append(dataRes, moderationFlagTransformer(flag))
+	}
+
+	res.Data = dataRes
+
+	return res
+}
+
+func reviewModerationFlagTransformer(data tables.ModerationFlags) utils.BaseResponse {
+	var res utils.BaseResponse
+
+	res.Success = true
+	res.StatusCode = http.StatusOK
+	res.Message = "moderation flag reviewed successfully"
+	res.Data = moderationFlagTransformer(data)
+
+	return res
+}
+
+func reportTransformer(data tables.Reports) reportsvc.ReportRes {
+	var res reportsvc.ReportRes
+
+	res.ReportPID = data.PID
+	res.ReporterPID = data.ReporterPID
+	res.TargetType = data.TargetType
+	res.TargetPID = data.TargetPID
+	res.Reason = data.Reason
+	res.Details = data.Details
+	res.Status = data.Status
+	res.ClaimedBy = data.ClaimedBy
+	res.ResolutionAction = data.ResolutionAction
+	res.ResolutionNotes = data.ResolutionNotes
+	res.ClosedAt = data.ClosedAt
+	res.CreatedAt = data.CreatedAt
+
+	return res
+}
+
+func listReportsTransformer(data []tables.Reports) utils.BaseResponse {
+	var res utils.BaseResponse
+	dataRes := []reportsvc.ReportRes{}
+
+	res.Success = true
+	res.StatusCode = http.StatusOK
+	res.Message = "reports fetched successfully"
+
+	for _, report := range data {
+		dataRes = append(dataRes, reportTransformer(report))
+	}
+
+	res.Data = dataRes
+
+	return res
+}
+
+func reportDetailsTransformer(data reportsvc.ReportDetails) utils.BaseResponse {
+	var res utils.BaseResponse
+	var dataRes reportsvc.ReportDetailsRes
+
+	res.Success = true
+	res.StatusCode = http.StatusOK
+	res.Message = "report fetched successfully"
+
+	dataRes.Report = reportTransformer(data.Report)
+	dataRes.AuditTrail = []reportsvc.AuditLogRes{}
+	for _, auditLog := range data.AuditTrail {
+		dataRes.AuditTrail = append(dataRes.AuditTrail, reportsvc.AuditLogRes{
+			AuditPID:   auditLog.PID,
+			ActorPID:   auditLog.ActorPID,
+			Action:     auditLog.Action,
+			EntityType: auditLog.EntityType,
+			EntityPID:  auditLog.EntityPID,
+			Notes:      auditLog.Notes,
+			CreatedAt:  auditLog.CreatedAt,
+		})
+	}
+
+	res.Data = dataRes
+
+	return res
+}
+
+func reportActionTransformer(baseRes utils.BaseResponse, data tables.Reports) utils.BaseResponse {
+	var res utils.BaseResponse
+
+	res.Success = true
+	res.StatusCode = http.StatusOK
+	res.Message = baseRes.Message
+	res.Data = reportTransformer(data)
+
+	return res
+}
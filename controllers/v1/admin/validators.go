@@ -0,0 +1,61 @@
+package admin
+
+import (
+	"io"
+
+	"github.com/BearTS/go-gin-monolith/services/moderationsvc"
+	"github.com/BearTS/go-gin-monolith/services/reportsvc"
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+)
+
+func validateListModerationFlagsReq(c *gin.Context) (moderationsvc.ListFlagsReq, error) {
+	var req moderationsvc.ListFlagsReq
+	err := c.ShouldBindQuery(&req)
+	if err != nil {
+		return req, err
+	}
+	return req, err
+}
+
+func validateReviewModerationFlagReq(c *gin.Context) (moderationsvc.ReviewFlagReq, error) {
+	var req moderationsvc.ReviewFlagReq
+	err := c.ShouldBindJSON(&req)
+	if err != nil {
+		return req, err
+	}
+	req.FlagPID = c.Param("pid")
+
+	return req, err
+}
+
+func validateListReportsReq(c *gin.Context) (reportsvc.ListReportsReq, error) {
+	var req reportsvc.ListReportsReq
+	err := c.ShouldBindQuery(&req)
+	if err != nil {
+		return req, err
+	}
+	return req, err
+}
+
+func validateGetReportReq(c *gin.Context) (string, error) {
+	reportPID := c.Param("pid")
+
+	return reportPID, nil
+}
+
+func validateReportActionReq(c *gin.Context) (reportsvc.ReportActionReq, error) {
+	var req reportsvc.ReportActionReq
+
+	// claim takes no body. Chunked and HTTP/2 requests may not carry a length,
+	// so bind whatever is there and treat an empty body as an empty request.
+	if c.Request.Body != nil && c.Request.ContentLength != 0 {
+		err := c.ShouldBindJSON(&req)
+		if err != nil && !errors.Is(err, io.EOF) {
+			return req, err
+		}
+	}
+	req.ReportPID = c.Param("pid")
+
+	return req, nil
+}
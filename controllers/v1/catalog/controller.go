@@ -0,0 +1,67 @@
+package catalog
+
+import (
+	"github.com/BearTS/go-gin-monolith/merrors"
+	"github.com/BearTS/go-gin-monolith/utils"
+	"github.com/gin-gonic/gin"
+)
+
+/* ------------------------------- Get Tracks ------------------------------- */
+func (h *catalogHandler) GetTracks(c *gin.Context) {
+
+	ids, err := validateLookupReq(c)
+	if err != nil {
+		merrors.Validation(c, err.Error())
+		return
+	}
+
+	res, err := h.catalogsvc.GetTracks(c, ids)
+	if err != nil {
+		merrors.InternalServer(c, "Internal Server Error")
+		return
+	}
+
+	finalRes := tracksTransformer(res)
+
+	utils.ReturnJSONStruct(c, finalRes)
+}
+
+/* ------------------------------- Get Artists ------------------------------ */
+func (h *catalogHandler) GetArtists(c *gin.Context) {
+
+	ids, err := validateLookupReq(c)
+	if err != nil {
+		merrors.Validation(c, err.Error())
+		return
+	}
+
+	res, err := h.catalogsvc.GetArtists(c, ids)
+	if err != nil {
+		merrors.InternalServer(c, "Internal Server Error")
+		return
+	}
+
+	finalRes := artistsTransformer(res)
+
+	utils.ReturnJSONStruct(c, finalRes)
+}
+
+/* ------------------------------- Get Albums ------------------------------- */
+func (h *catalogHandler) GetAlbums(c *gin.Context) {
+
+	ids, err := validateLookupReq(c)
+	if err != nil {
+		merrors.Validation(c, err.Error())
+		return
+	}
+
+	res, err := h.catalogsvc.GetAlbums(c, ids)
+	if err != nil {
+		merrors.InternalServer(c, "Internal Server Error")
+		return
+	}
+
+	finalRes := albumsTransformer(res)
+
+	utils.ReturnJSONStruct(c, finalRes)
+}
@@ -0,0 +1,13 @@
+package catalog
+
+import "github.com/BearTS/go-gin-monolith/services/catalogsvc"
+
+type catalogHandler struct {
+	catalogsvc catalogsvc.Interface
+}
+
+func Handler(catalogSvc catalogsvc.Interface) *catalogHandler {
+	return &catalogHandler{
+		catalogsvc: catalogSvc,
+	}
+}
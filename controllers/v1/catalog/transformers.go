@@ -0,0 +1,84 @@
+package catalog
+
+import (
+	"net/http"
+
+	"github.com/BearTS/go-gin-monolith/database/tables"
+	"github.com/BearTS/go-gin-monolith/services/catalogsvc"
+	"github.com/BearTS/go-gin-monolith/utils"
+)
+
+func tracksTransformer(data []tables.Tracks) utils.BaseResponse {
+	var res utils.BaseResponse
+
+	res.Success = true
+	res.StatusCode = http.StatusOK
+	res.Message = "tracks fetched successfully"
+
+	dataRes := make([]catalogsvc.TrackRes, 0, len(data))
+	for _, track := range data {
+		dataRes = append(dataRes, catalogsvc.TrackRes{
+			SpotifyID:  track.SpotifyID,
+			Name:       track.Name,
+			AlbumID:    track.AlbumID,
+			ArtistIDs:  track.ArtistIDs,
+			DurationMs: track.DurationMs,
+			Explicit:   track.Explicit,
+			Popularity: track.Popularity,
+			Images:     track.Images,
+			URI:        track.URI,
+		})
+	}
+	res.Data = dataRes
+
+	return res
+}
+
+func artistsTransformer(data []tables.Artists) utils.BaseResponse {
+	var res utils.BaseResponse
+
+	res.Success = true
+	res.StatusCode = http.StatusOK
+	res.Message = "artists fetched successfully"
+
+	dataRes := make([]catalogsvc.ArtistRes, 0, len(data))
+	for _, artist := range data {
+		dataRes = append(dataRes, catalogsvc.ArtistRes{
+			SpotifyID:  artist.SpotifyID,
+			Name:       artist.Name,
+			Genres:     artist.Genres,
+			Popularity: artist.Popularity,
+			Images:     artist.Images,
+			URI:        artist.URI,
+		})
+	}
+	res.Data = dataRes
+
+	return res
+}
+
+func albumsTransformer(data []tables.Albums) utils.BaseResponse {
+	var res utils.BaseResponse
+
+	res.Success = true
+	res.StatusCode = http.StatusOK
+	res.Message = "albums fetched successfully"
+
+	dataRes := make([]catalogsvc.AlbumRes, 0, len(data))
+	for _, album := range data {
+		dataRes = append(dataRes, catalogsvc.AlbumRes{
+			SpotifyID:   album.SpotifyID,
+			Name:        album.Name,
+			AlbumType:   album.AlbumType,
+			ReleaseDate: album.ReleaseDate,
+			ArtistIDs:   album.ArtistIDs,
+			Genres:      album.Genres,
+			Popularity:  album.Popularity,
+			Images:      album.Images,
+			URI:         album.URI,
+		})
+	}
+	res.Data = dataRes
+
+	return res
+}
@@ -0,0 +1,38 @@
+package catalog
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+)
+
+// maxLookupIDs bounds one request, the service batches them for Spotify
+const maxLookupIDs = 100
+
+type lookupQuery struct {
+	IDs string `form:"ids" binding:"required"` // comma separated Spotify IDs
+}
+
+func validateLookupReq(c *gin.Context) ([]string, error) {
+	var query lookupQuery
+	err := c.ShouldBindQuery(&query)
+	if err != nil {
+		return nil, err
+	}
+
+	var ids []string
+	for _, id := range strings.Split(query.IDs, ",") {
+		if id = strings.TrimSpace(id); id != "" {
+			ids = append(ids, id)
+		}
+	}
+	if len(ids) == 0 {
+		return nil, errors.New("ids is required")
+	}
+	if len(ids) > maxLookupIDs {
+		return nil, fmt.Errorf("at most %d ids can be looked up at once", maxLookupIDs)
+	}
+	return ids, nil
+}
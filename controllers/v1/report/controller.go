@@ -0,0 +1,34 @@
+package report
+
+import (
+	"net/http"
+
+	"github.com/BearTS/go-gin-monolith/merrors"
+	"github.com/BearTS/go-gin-monolith/utils"
+	"github.com/gin-gonic/gin"
+)
+
+/* ------------------------------ Create Report ----------------------------- */
+func (h *reportHandler) CreateReport(c *gin.Context) {
+
+	req, err := validateCreateReportReq(c)
+	if err != nil {
+		merrors.Validation(c, err.Error())
+		return
+	}
+
+	baseRes, res, err := h.reportsvc.CreateReport(c, req)
+	if err != nil {
+		merrors.InternalServer(c, baseRes.Message)
+		return
+	}
+
+	if baseRes.StatusCode != http.StatusOK {
+		merrors.HandleServiceCodes(c, baseRes)
+		return
+	}
+
+	finalRes := createReportTransformer(res)
+
+	utils.ReturnJSONStruct(c, finalRes)
+}
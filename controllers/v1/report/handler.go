@@ -0,0 +1,13 @@
+package report
+
+import "github.com/BearTS/go-gin-monolith/services/reportsvc"
+
+type reportHandler struct {
+	reportsvc reportsvc.Interface
+}
+
+func Handler(reportSvc reportsvc.Interface) *reportHandler {
+	return &reportHandler{
+		reportsvc: reportSvc,
+	}
+}
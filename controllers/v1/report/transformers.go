@@ -0,0 +1,25 @@
+package report
+
+import (
+	"net/http"
+
+	"github.com/BearTS/go-gin-monolith/database/tables"
+	"github.com/BearTS/go-gin-monolith/services/reportsvc"
+	"github.com/BearTS/go-gin-monolith/utils"
+)
+
+func createReportTransformer(data tables.Reports) utils.BaseResponse {
+	var res utils.BaseResponse
+	var dataRes reportsvc.CreateReportRes
+
+	res.Success = true
+	res.StatusCode = http.StatusOK
+	res.Message = "report filed successfully"
+
+	dataRes.ReportPID = data.PID
+	dataRes.Status = data.Status
+
+	res.Data = dataRes
+
+	return res
+}
@@ -0,0 +1,15 @@
+package report
+
+import (
+	"github.com/BearTS/go-gin-monolith/services/reportsvc"
+	"github.com/gin-gonic/gin"
+)
+
+func validateCreateReportReq(c *gin.Context) (reportsvc.CreateReportReq, error) {
+	var req reportsvc.CreateReportReq
+	err := c.ShouldBindJSON(&req)
+	if err != nil {
+		return req, err
+	}
+	return req, err
+}
@@ -0,0 +1,34 @@
+package search
+
+import (
+	"net/http"
+
+	"github.com/BearTS/go-gin-monolith/merrors"
+	"github.com/BearTS/go-gin-monolith/utils"
+	"github.com/gin-gonic/gin"
+)
+
+/* --------------------------------- Search --------------------------------- */
+func (h *searchHandler) Search(c *gin.Context) {
+
+	req, err := validateSearchReq(c)
+	if err != nil {
+		merrors.Validation(c, err.Error())
+		return
+	}
+
+	baseRes, res, err := h.searchsvc.Search(c, req)
+	if err != nil {
+		merrors.InternalServer(c, baseRes.Message)
+		return
+	}
+
+	if baseRes.StatusCode != http.StatusOK {
+		merrors.HandleServiceCodes(c, baseRes)
+		return
+	}
+
+	finalRes := searchTransformer(res)
+
+	utils.ReturnJSONStruct(c, finalRes)
+}
@@ -0,0 +1,13 @@
+package search
+
+import "github.com/BearTS/go-gin-monolith/services/searchsvc"
+
+type searchHandler struct {
+	searchsvc searchsvc.Interface
+}
+
+func Handler(searchSvc searchsvc.Interface) *searchHandler {
+	return &searchHandler{
+		searchsvc: searchSvc,
+	}
+}
@@ -0,0 +1,19 @@
+package search
+
+import (
+	"net/http"
+
+	"github.com/BearTS/go-gin-monolith/services/searchsvc"
+	"github.com/BearTS/go-gin-monolith/utils"
+)
+
+func searchTransformer(data searchsvc.SearchRes) utils.BaseResponse {
+	var res utils.BaseResponse
+
+	res.Success = true
+	res.StatusCode = http.StatusOK
+	res.Message = "search results fetched successfully"
+	res.Data = data
+
+	return res
+}
@@ -0,0 +1,15 @@
+package search
+
+import (
+	"github.com/BearTS/go-gin-monolith/services/searchsvc"
+	"github.com/gin-gonic/gin"
+)
+
+func validateSearchReq(c *gin.Context) (searchsvc.SearchReq, error) {
+	var req searchsvc.SearchReq
+	err := c.ShouldBindQuery(&req)
+	if err != nil {
+		return req, err
+	}
+	return req, err
+}
@@ -77,10 +77,29 @@ func (h *userHandler) VerifyOTP(c *gin.Context) {
 	}
 
 	if baseRes.StatusCode != http.StatusOK {
+		merrors.HandleServiceCodes(c, baseRes)
+		return
+	}
+
+	finalRes := baseRes
+
+	utils.ReturnJSONStruct(c, finalRes)
+}
+
+/* ------------------------------ Refresh Token ----------------------------- */
+func (h *userHandler) RefreshToken(c *gin.Context) {
+
+	baseRes, _, err := h.usersvc.RefreshToken(c)
+	if err != nil {
 		merrors.InternalServer(c, baseRes.Message)
 		return
 	}
 
+	if baseRes.StatusCode != http.StatusOK {
+		merrors.HandleServiceCodes(c, baseRes)
+		return
+	}
+
 	finalRes := baseRes
 
 	utils.ReturnJSONStruct(c, finalRes)
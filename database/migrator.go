@@ -14,6 +14,13 @@ func AutoMigrate(db *gorm.DB) []Migrate {
 	var users tables.Users
 	var otpVerifications tables.OtpVerifications
 	var devices tables.Devices
+	var guests tables.Guests
+	var moderationFlags tables.ModerationFlags
+	var reports tables.Reports
+	var auditLogs tables.AuditLogs
+	var tracks tables.Tracks
+	var artists tables.Artists
+	var albums tables.Albums
 
 	usersM := Migrate{TableName: "users",
 		Run: func(d *gorm.DB) error { return db.AutoMigrate(&users) }}
@@ -21,10 +28,31 @@ func AutoMigrate(db *gorm.DB) []Migrate {
 		Run: func(d *gorm.DB) error { return db.AutoMigrate(&otpVerifications) }}
 	devicesM := Migrate{TableName: "devices",
 		Run: func(d *gorm.DB) error { return db.AutoMigrate(&devices) }}
+	guestsM := Migrate{TableName: "guests",
+		Run: func(d *gorm.DB) error { return db.AutoMigrate(&guests) }}
+	moderationFlagsM := Migrate{TableName: "moderation_flags",
+		Run: func(d *gorm.DB) error { return db.AutoMigrate(&moderationFlags) }}
+	reportsM := Migrate{TableName: "reports",
+		Run: func(d *gorm.DB) error { return db.AutoMigrate(&reports) }}
+	auditLogsM := Migrate{TableName: "audit_logs",
+		Run: func(d *gorm.DB) error { return db.AutoMigrate(&auditLogs) }}
+	tracksM := Migrate{TableName: "tracks",
+		Run: func(d *gorm.DB) error { return db.AutoMigrate(&tracks) }}
+	artistsM := Migrate{TableName: "artists",
+		Run: func(d *gorm.DB) error { return db.AutoMigrate(&artists) }}
+	albumsM := Migrate{TableName: "albums",
+		Run: func(d *gorm.DB) error { return db.AutoMigrate(&albums) }}
 
 	return []Migrate{
 		usersM,
 		otpVerificationM,
 		devicesM,
+		guestsM,
+		moderationFlagsM,
+		reportsM,
+		auditLogsM,
+		tracksM,
+		artistsM,
+		albumsM,
 	}
 }
@@ -0,0 +1,20 @@
+package tables
+
+import "time"
+
+// Albums caches Spotify album metadata, FetchedAt drives the refresh TTL
+type Albums struct {
+	SpotifyID   string      `gorm:"column:spotify_id;primaryKey;type:varchar(40)"`
+	Name        string      `gorm:"column:name;not null;type:varchar(500)"`
+	AlbumType   string      `gorm:"column:album_type;type:varchar(20)"`
+	ReleaseDate string      `gorm:"column:release_date;type:varchar(10)"`
+	ArtistIDs   StringArray `gorm:"column:artist_ids;type:text[]"`
+	Images      JSONB       `gorm:"column:images;type:json"`
+	Genres      StringArray `gorm:"column:genres;type:text[]"`
+	Markets     StringArray `gorm:"column:markets;type:text[]"`
+	Popularity  int         `gorm:"column:popularity;not null;default:0"`
+	URI         string      `gorm:"column:uri;not null;type:varchar(100)"`
+	FetchedAt   time.Time   `gorm:"column:fetched_at;not null;index"`
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
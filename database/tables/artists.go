@@ -0,0 +1,16 @@
+package tables
+
+import "time"
+
+// Artists caches Spotify artist metadata, FetchedAt drives the refresh TTL
+type Artists struct {
+	SpotifyID  string      `gorm:"column:spotify_id;primaryKey;type:varchar(40)"`
+	Name       string      `gorm:"column:name;not null;type:varchar(500)"`
+	Genres     StringArray `gorm:"column:genres;type:text[]"`
+	Images     JSONB       `gorm:"column:images;type:json"`
+	Popularity int         `gorm:"column:popularity;not null;default:0"`
+	URI        string      `gorm:"column:uri;not null;type:varchar(100)"`
+	FetchedAt  time.Time   `gorm:"column:fetched_at;not null;index"`
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
@@ -0,0 +1,17 @@
+package tables
+
+import "time"
+
+// AuditLogs is append only, rows are never updated or deleted
+type AuditLogs struct {
+	ID         int    `gorm:"column:audit_id;primaryKey;autoIncrement"`
+	PID        string `gorm:"column:audit_pid;unique;not null;type:varchar(40)"`
+	ActorPID   string `gorm:"column:actor_pid;not null;type:varchar(40)"`
+	Action     string `gorm:"column:action;not null;type:varchar(40)"`
+	EntityType string `gorm:"column:entity_type;not null;type:varchar(40)"`
+	EntityPID  string `gorm:"column:entity_pid;not null;type:varchar(40)"`
+	Notes      string `gorm:"column:notes;type:text"`
+	Metadata   JSONB  `gorm:"column:metadata;type:json"`
+	IsSandbox  bool   `gorm:"column:is_sandbox;not null;default:false"`
+	CreatedAt  time.Time
+}
@@ -14,8 +14,12 @@ import (
 // JSONB Interface for JSONB Field of yourTableName Table
 type JSONB []interface{}
 
-// Value Marshal
-func (a *JSONB) Value() (driver.Value, error) {
+// Value Marshal, on the value so gorm writes the column as json instead of
+// expanding the slice into one bind parameter per element. nil stays SQL NULL.
+func (a JSONB) Value() (driver.Value, error) {
+	if a == nil {
+		return nil, nil
+	}
 	return json.Marshal(a)
 }
 
@@ -75,9 +79,68 @@ func appendArrayQuotedBytes(b, v []byte) []byte {
 }
 
 func (a *StringArray) Scan(value interface{}) error {
-	b, ok := value.([]byte)
-	if !ok {
+	var b []byte
+	switch v := value.(type) {
+	case []byte:
+		b = v
+	case string:
+		b = []byte(v)
+	case nil:
+		*a = nil
+		return nil
+	default:
 		return errors.New("type assertion to []byte failed")
 	}
+
+	// text[] columns come back as postgres array literals, json columns as json
+	if len(b) > 0 && b[0] == '{' {
+		return a.scanArrayLiteral(b)
+	}
 	return json.Unmarshal(b, &a)
 }
+
+// scanArrayLiteral parses the {"a","b c",d} form written by Value
+func (a *StringArray) scanArrayLiteral(b []byte) error {
+	if len(b) < 2 || b[len(b)-1] != '}' {
+		return errors.New("invalid array literal")
+	}
+	b = b[1 : len(b)-1]
+
+	res := StringArray{}
+	for len(b) > 0 {
+		var elem []byte
+		if b[0] == '"' {
+			i := 1
+			for ; i < len(b) && b[i] != '"'; i++ {
+				if b[i] == '\\' {
+					i++
+				}
+				if i < len(b) {
+					elem = append(elem, b[i])
+				}
+			}
+			if i >= len(b) {
+				return errors.New("invalid array literal")
+			}
+			b = b[i+1:]
+		} else {
+			i := bytes.IndexByte(b, ',')
+			if i < 0 {
+				i = len(b)
+			}
+			elem = b[:i]
+			b = b[i:]
+		}
+		res = append(res, string(elem))
+
+		if len(b) > 0 {
+			if b[0] != ',' {
+				return errors.New("invalid array literal")
+			}
+			b = b[1:]
+		}
+	}
+
+	*a = res
+	return nil
+}
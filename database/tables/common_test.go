@@ -0,0 +1,22 @@
+package tables
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJSONBValue(t *testing.T) {
+	var unset JSONB
+	v, err := unset.Value()
+	assert.NoError(t, err)
+	assert.Nil(t, v)
+
+	v, err = JSONB{}.Value()
+	assert.NoError(t, err)
+	assert.Equal(t, []byte(`[]`), v)
+
+	v, err = JSONB{map[string]interface{}{"url": "a"}}.Value()
+	assert.NoError(t, err)
+	assert.Equal(t, []byte(`[{"url":"a"}]`), v)
+}
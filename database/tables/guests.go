@@ -0,0 +1,16 @@
+package tables
+
+import "time"
+
+type Guests struct {
+	ID          int       `gorm:"column:guest_id;primaryKey;autoIncrement"`
+	PID         string    `gorm:"column:guest_pid;unique;not null;type:varchar(40)"`
+	RoomPID     string    `gorm:"column:room_pid;not null;type:varchar(40)"`
+	DisplayName string    `gorm:"column:display_name;not null;type:varchar(50)"`
+	UserPID     string    `gorm:"column:user_pid;type:varchar(40)"` // set once the guest upgrades to a full user
+	ExpiresAt   time.Time `gorm:"column:expires_at"`
+	IsDeleted   bool      `gorm:"column:is_deleted;not null;default:false"`
+	IsSandbox   bool      `gorm:"column:is_sandbox;not null;default:false"`
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
@@ -0,0 +1,20 @@
+package tables
+
+import "time"
+
+type ModerationFlags struct {
+	ID          int    `gorm:"column:flag_id;primaryKey;autoIncrement"`
+	PID         string `gorm:"column:flag_pid;unique;not null;type:varchar(40)"`
+	Source      string `gorm:"column:source;not null;type:varchar(40)"` // e.g. display_name, chat_message
+	SourcePID   string `gorm:"column:source_pid;type:varchar(40)"`
+	AuthorPID   string `gorm:"column:author_pid;type:varchar(40)"`
+	Text        string `gorm:"column:text;not null;type:text"`
+	Matches     JSONB  `gorm:"column:matches;type:json"`
+	Status      string `gorm:"column:status;not null;type:varchar(20)"`
+	ReviewedBy  string `gorm:"column:reviewed_by;type:varchar(40)"`
+	ReviewNotes string `gorm:"column:review_notes;type:text"`
+	IsDeleted   bool   `gorm:"column:is_deleted;not null;default:false"`
+	IsSandbox   bool   `gorm:"column:is_sandbox;not null;default:false"`
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
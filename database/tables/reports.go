@@ -0,0 +1,22 @@
+package tables
+
+import "time"
+
+type Reports struct {
+	ID               int        `gorm:"column:report_id;primaryKey;autoIncrement"`
+	PID              string     `gorm:"column:report_pid;unique;not null;type:varchar(40)"`
+	ReporterPID      string     `gorm:"column:reporter_pid;not null;type:varchar(40)"`
+	TargetType       string     `gorm:"column:target_type;not null;type:varchar(20)"`
+	TargetPID        string     `gorm:"column:target_pid;not null;type:varchar(40)"`
+	Reason           string     `gorm:"column:reason;not null;type:varchar(100)"`
+	Details          string     `gorm:"column:details;type:text"`
+	Status           string     `gorm:"column:status;not null;type:varchar(20)"`
+	ClaimedBy        string     `gorm:"column:claimed_by;type:varchar(40)"`
+	ResolutionAction string     `gorm:"column:resolution_action;type:varchar(40)"`
+	ResolutionNotes  string     `gorm:"column:resolution_notes;type:text"`
+	ClosedAt         *time.Time `gorm:"column:closed_at"`
+	IsDeleted        bool       `gorm:"column:is_deleted;not null;default:false"`
+	IsSandbox        bool       `gorm:"column:is_sandbox;not null;default:false"`
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+}
@@ -0,0 +1,20 @@
+package tables
+
+import "time"
+
+// Tracks caches Spotify track metadata, FetchedAt drives the refresh TTL
+type Tracks struct {
+	SpotifyID  string      `gorm:"column:spotify_id;primaryKey;type:varchar(40)"`
+	Name       string      `gorm:"column:name;not null;type:varchar(500)"`
+	AlbumID    string      `gorm:"column:album_id;type:varchar(40)"`
+	ArtistIDs  StringArray `gorm:"column:artist_ids;type:text[]"`
+	DurationMs int         `gorm:"column:duration_ms;not null;default:0"`
+	Popularity int         `gorm:"column:popularity;not null;default:0"`
+	Explicit   bool        `gorm:"column:explicit;not null;default:false"`
+	URI        string      `gorm:"column:uri;not null;type:varchar(100)"`
+	Images     JSONB       `gorm:"column:images;type:json"` // album art
+	Markets    StringArray `gorm:"column:markets;type:text[]"`
+	FetchedAt  time.Time   `gorm:"column:fetched_at;not null;index"`
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
@@ -13,6 +13,7 @@ type Users struct {
 	RegistrationNumber string `gorm:"column:user_registration_number;not null;type:varchar(9)"`
 	DefaultAddressPID  string `gorm:"column:default_address_pid;not null;type:varchar(40)"`
 	Metadata           JSONB  `gorm:"column:metadata;type:json"`
+	IsSuspended        bool   `gorm:"column:is_suspended;not null;default:false"`
 	IsDeleted          bool   `gorm:"column:is_deleted;not null;default:false"`
 	IsSandbox          bool   `gorm:"column:is_sandbox;not null;default:false"`
 	CreatedAt          time.Time
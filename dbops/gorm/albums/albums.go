@@ -0,0 +1,64 @@
+package albums
+
+import (
+	"github.com/BearTS/go-gin-monolith/database/tables"
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+/* -------------------------------------------------------------------------- */
+/*                                  Interface                                 */
+/* -------------------------------------------------------------------------- */
+type GormInterface interface {
+	GetAlbumsBySpotifyIDs(ctx *gin.Context, spotifyIDs []string) ([]tables.Albums, error)
+	UpsertAlbums(ctx *gin.Context, albums []tables.Albums) error
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                   Handler                                  */
+/* -------------------------------------------------------------------------- */
+func Gorm(gormDB *gorm.DB) *albumsGormImpl {
+	return &albumsGormImpl{
+		DB: gormDB,
+	}
+}
+
+type albumsGormImpl struct {
+	DB *gorm.DB
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                   Methods                                  */
+/* -------------------------------------------------------------------------- */
+
+// catalog rows are shared by everyone, so no sandbox or user scopes apply
+
+func (r *albumsGormImpl) GetAlbumsBySpotifyIDs(ctx *gin.Context, spotifyIDs []string) ([]tables.Albums, error) {
+	var albums []tables.Albums
+
+	err := r.DB.Session(&gorm.Session{}).Where("spotify_id IN ?", spotifyIDs).
+		Find(&albums).Error
+
+	if err != nil {
+		return albums, errors.Wrap(err, "[albumsGormImpl][GetAlbumsBySpotifyIDs]")
+	}
+	return albums, nil
+}
+
+func (r *albumsGormImpl) UpsertAlbums(ctx *gin.Context, albums []tables.Albums) error {
+	if len(albums) == 0 {
+		return nil
+	}
+
+	err := r.DB.Session(&gorm.Session{}).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "spotify_id"}},
+		UpdateAll: true,
+	}).Create(&albums).Error
+
+	if err != nil {
+		return errors.Wrap(err, "[albumsGormImpl][UpsertAlbums]")
+	}
+	return nil
+}
@@ -0,0 +1,64 @@
+package artists
+
+import (
+	"github.com/BearTS/go-gin-monolith/database/tables"
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+/* -------------------------------------------------------------------------- */
+/*                                  Interface                                 */
+/* -------------------------------------------------------------------------- */
+type GormInterface interface {
+	GetArtistsBySpotifyIDs(ctx *gin.Context, spotifyIDs []string) ([]tables.Artists, error)
+	UpsertArtists(ctx *gin.Context, artists []tables.Artists) error
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                   Handler                                  */
+/* -------------------------------------------------------------------------- */
+func Gorm(gormDB *gorm.DB) *artistsGormImpl {
+	return &artistsGormImpl{
+		DB: gormDB,
+	}
+}
+
+type artistsGormImpl struct {
+	DB *gorm.DB
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                   Methods                                  */
+/* -------------------------------------------------------------------------- */
+
+// catalog rows are shared by everyone, so no sandbox or user scopes apply
+
+func (r *artistsGormImpl) GetArtistsBySpotifyIDs(ctx *gin.Context, spotifyIDs []string) ([]tables.Artists, error) {
+	var artists []tables.Artists
+
+	err := r.DB.Session(&gorm.Session{}).Where("spotify_id IN ?", spotifyIDs).
+		Find(&artists).Error
+
+	if err != nil {
+		return artists, errors.Wrap(err, "[artistsGormImpl][GetArtistsBySpotifyIDs]")
+	}
+	return artists, nil
+}
+
+func (r *artistsGormImpl) UpsertArtists(ctx *gin.Context, artists []tables.Artists) error {
+	if len(artists) == 0 {
+		return nil
+	}
+
+	err := r.DB.Session(&gorm.Session{}).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "spotify_id"}},
+		UpdateAll: true,
+	}).Create(&artists).Error
+
+	if err != nil {
+		return errors.Wrap(err, "[artistsGormImpl][UpsertArtists]")
+	}
+	return nil
+}
@@ -0,0 +1,74 @@
+package audit_logs
+
+import (
+	"github.com/BearTS/go-gin-monolith/constants"
+	"github.com/BearTS/go-gin-monolith/database/tables"
+	"github.com/BearTS/go-gin-monolith/dbops"
+	"github.com/BearTS/go-gin-monolith/utils"
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+/* -------------------------------------------------------------------------- */
+/*                                  Interface                                 */
+/* -------------------------------------------------------------------------- */
+type GormInterface interface {
+	CreateAuditLog(ctx *gin.Context, auditLog tables.AuditLogs) (tables.AuditLogs, error)
+	GetAuditLogsByEntityPID(ctx *gin.Context, entityPID string) ([]tables.AuditLogs, error)
+	WithTx(tx *gorm.DB) GormInterface
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                   Handler                                  */
+/* -------------------------------------------------------------------------- */
+func Gorm(gormDB *gorm.DB) *auditLogsGormImpl {
+	return &auditLogsGormImpl{
+		DB: gormDB,
+	}
+}
+
+type auditLogsGormImpl struct {
+	DB *gorm.DB
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                   Methods                                  */
+/* -------------------------------------------------------------------------- */
+
+// CreateAuditLog records an action taken by the admin making the request
+func (r *auditLogsGormImpl) CreateAuditLog(ctx *gin.Context, auditLog tables.AuditLogs) (tables.AuditLogs, error) {
+	authData, err := utils.GetAuthData(ctx)
+	if err != nil {
+		return auditLog, errors.Wrap(err, "[auditLogsGormImpl][CreateAuditLog][GetAuthData]")
+	}
+
+	auditLog.PID = utils.UUIDWithPrefix(constants.Prefix.AUDITLOG)
+	auditLog.ActorPID = authData.AdminPID
+	auditLog.IsSandbox = authData.Sandbox
+
+	err = r.DB.Session(&gorm.Session{}).Create(&auditLog).Error
+	if err != nil {
+		return auditLog, errors.Wrap(err, "[auditLogsGormImpl][CreateAuditLog]")
+	}
+	return auditLog, nil
+}
+
+func (r *auditLogsGormImpl) GetAuditLogsByEntityPID(ctx *gin.Context, entityPID string) ([]tables.AuditLogs, error) {
+	var auditLogs []tables.AuditLogs
+
+	err := r.DB.Session(&gorm.Session{}).Where("entity_pid = ?", entityPID).
+		Scopes(dbops.SandboxScopes(ctx)).
+		Order("created_at ASC").
+		Find(&auditLogs).Error
+
+	if err != nil {
+		return auditLogs, errors.Wrap(err, "[auditLogsGormImpl][GetAuditLogsByEntityPID]")
+	}
+	return auditLogs, nil
+}
+
+// WithTx runs the methods inside an open transaction
+func (r *auditLogsGormImpl) WithTx(tx *gorm.DB) GormInterface {
+	return Gorm(tx)
+}
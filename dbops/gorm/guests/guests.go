@@ -0,0 +1,99 @@
+package guests
+
+import (
+	"github.com/BearTS/go-gin-monolith/constants"
+	"github.com/BearTS/go-gin-monolith/database/tables"
+	"github.com/BearTS/go-gin-monolith/dbops"
+	"github.com/BearTS/go-gin-monolith/utils"
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+/* -------------------------------------------------------------------------- */
+/*                                  Interface                                 */
+/* -------------------------------------------------------------------------- */
+type GormInterface interface {
+	CreateGuest(ctx *gin.Context, guest tables.Guests) (tables.Guests, error)
+	GetGuestByPID(ctx *gin.Context, pid string) (tables.Guests, error)
+	LinkUser(ctx *gin.Context, guestPID string, userPID string) error
+	RevokeGuest(ctx *gin.Context, pid string) error
+	WithTx(tx *gorm.DB) GormInterface
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                   Handler                                  */
+/* -------------------------------------------------------------------------- */
+func Gorm(gormDB *gorm.DB) *guestsGormImpl {
+	return &guestsGormImpl{
+		DB: gormDB,
+	}
+}
+
+type guestsGormImpl struct {
+	DB *gorm.DB
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                   Methods                                  */
+/* -------------------------------------------------------------------------- */
+
+func (r *guestsGormImpl) CreateGuest(ctx *gin.Context, guest tables.Guests) (tables.Guests, error) {
+	guest.PID = utils.UUIDWithPrefix(constants.Prefix.GUEST)
+
+	err := r.DB.Session(&gorm.Session{}).Create(&guest).Error
+	if err != nil {
+		return guest, errors.Wrap(err, "[guestsGormImpl][CreateGuest]")
+	}
+	return guest, nil
+}
+
+func (r *guestsGormImpl) GetGuestByPID(ctx *gin.Context, pid string) (tables.Guests, error) {
+	var guest tables.Guests
+
+	err := r.DB.Session(&gorm.Session{}).Where("guest_pid = ?", pid).
+		Scopes(dbops.DeletedScopes(ctx)).
+		Take(&guest).Error
+
+	if err != nil {
+		return guest, errors.Wrap(err, "[guestsGormImpl][GetGuestByPID]")
+	}
+	return guest, nil
+}
+
+// LinkUser records the full user a guest upgraded to, so anything the
+// guest did in the room can be carried over to that user
+func (r *guestsGormImpl) LinkUser(ctx *gin.Context, guestPID string, userPID string) error {
+	err := r.DB.Session(&gorm.Session{}).Model(&tables.Guests{}).
+		Where("guest_pid = ?", guestPID).
+		Where("user_pid = ? OR user_pid IS NULL", "").
+		Scopes(dbops.DeletedScopes(ctx)).
+		Update("user_pid", userPID).Error
+
+	if err != nil {
+		return errors.Wrap(err, "[guestsGormImpl][LinkUser]")
+	}
+	return nil
+}
+
+// RevokeGuest soft deletes a guest so their tokens stop working and can't be
+// refreshed. gorm.ErrRecordNotFound means there is no such guest.
+func (r *guestsGormImpl) RevokeGuest(ctx *gin.Context, pid string) error {
+	result := r.DB.Session(&gorm.Session{}).Model(&tables.Guests{}).
+		Where("guest_pid = ?", pid).
+		Scopes(dbops.DeletedScopes(ctx)).
+		Update("is_deleted", true)
+
+	if result.Error != nil {
+		return errors.Wrap(result.Error, "[guestsGormImpl][RevokeGuest]")
+	}
+	if result.RowsAffected == 0 {
+		return errors.Wrap(gorm.ErrRecordNotFound, "[guestsGormImpl][RevokeGuest]")
+	}
+	return nil
+}
+
+// WithTx runs the methods inside an open transaction
+func (r *guestsGormImpl) WithTx(tx *gorm.DB) GormInterface {
+	return Gorm(tx)
+}
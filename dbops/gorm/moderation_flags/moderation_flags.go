@@ -0,0 +1,98 @@
+package moderation_flags
+
+import (
+	"github.com/BearTS/go-gin-monolith/constants"
+	"github.com/BearTS/go-gin-monolith/database/tables"
+	"github.com/BearTS/go-gin-monolith/dbops"
+	"github.com/BearTS/go-gin-monolith/utils"
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+// ErrFlagAlreadyReviewed means the flag left the status the caller expected
+var ErrFlagAlreadyReviewed = errors.New("flag has already been reviewed")
+
+/* -------------------------------------------------------------------------- */
+/*                                  Interface                                 */
+/* -------------------------------------------------------------------------- */
+type GormInterface interface {
+	CreateFlag(ctx *gin.Context, flag tables.ModerationFlags) (tables.ModerationFlags, error)
+	GetFlagByPID(ctx *gin.Context, pid string) (tables.ModerationFlags, error)
+	GetFlagsByStatus(ctx *gin.Context, status string) ([]tables.ModerationFlags, error)
+	UpdateFlag(ctx *gin.Context, flag tables.ModerationFlags, fromStatus string) (tables.ModerationFlags, error)
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                   Handler                                  */
+/* -------------------------------------------------------------------------- */
+func Gorm(gormDB *gorm.DB) *moderationFlagsGormImpl {
+	return &moderationFlagsGormImpl{
+		DB: gormDB,
+	}
+}
+
+type moderationFlagsGormImpl struct {
+	DB *gorm.DB
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                   Methods                                  */
+/* -------------------------------------------------------------------------- */
+
+func (r *moderationFlagsGormImpl) CreateFlag(ctx *gin.Context, flag tables.ModerationFlags) (tables.ModerationFlags, error) {
+	flag.PID = utils.UUIDWithPrefix(constants.Prefix.MODERATIONFLAG)
+
+	err := r.DB.Session(&gorm.Session{}).Create(&flag).Error
+	if err != nil {
+		return flag, errors.Wrap(err, "[moderationFlagsGormImpl][CreateFlag]")
+	}
+	return flag, nil
+}
+
+func (r *moderationFlagsGormImpl) GetFlagByPID(ctx *gin.Context, pid string) (tables.ModerationFlags, error) {
+	var flag tables.ModerationFlags
+
+	err := r.DB.Session(&gorm.Session{}).Where("flag_pid = ?", pid).
+		Scopes(dbops.DeletedScopes(ctx)).
+		Scopes(dbops.SandboxScopes(ctx)).
+		Take(&flag).Error
+
+	if err != nil {
+		return flag, errors.Wrap(err, "[moderationFlagsGormImpl][GetFlagByPID]")
+	}
+	return flag, nil
+}
+
+// GetFlagsByStatus returns the review queue oldest first
+func (r *moderationFlagsGormImpl) GetFlagsByStatus(ctx *gin.Context, status string) ([]tables.ModerationFlags, error) {
+	var flags []tables.ModerationFlags
+
+	err := r.DB.Session(&gorm.Session{}).Where("status = ?", status).
+		Scopes(dbops.DeletedScopes(ctx)).
+		Scopes(dbops.SandboxScopes(ctx)).
+		Order("created_at ASC").
+		Find(&flags).Error
+
+	if err != nil {
+		return flags, errors.Wrap(err, "[moderationFlagsGormImpl][GetFlagsByStatus]")
+	}
+	return flags, nil
+}
+
+// UpdateFlag only applies while the flag is still in fromStatus, so two reviewers
+// can't both move it. ErrFlagAlreadyReviewed means someone else got there first.
+func (r *moderationFlagsGormImpl) UpdateFlag(ctx *gin.Context, flag tables.ModerationFlags, fromStatus string) (tables.ModerationFlags, error) {
+	result := r.DB.Session(&gorm.Session{}).Where("flag_pid = ?", flag.PID).
+		Where("status = ?", fromStatus).
+		Scopes(dbops.DeletedScopes(ctx)).
+		Updates(&flag)
+
+	if result.Error != nil {
+		return flag, errors.Wrap(result.Error, "[moderationFlagsGormImpl][UpdateFlag]")
+	}
+	if result.RowsAffected == 0 {
+		return flag, ErrFlagAlreadyReviewed
+	}
+	return flag, nil
+}
@@ -0,0 +1,111 @@
+package reports
+
+import (
+	"github.com/BearTS/go-gin-monolith/constants"
+	"github.com/BearTS/go-gin-monolith/database/tables"
+	"github.com/BearTS/go-gin-monolith/dbops"
+	"github.com/BearTS/go-gin-monolith/utils"
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+// ErrReportChanged means the report left the status or claimant the caller read
+var ErrReportChanged = errors.New("report was changed")
+
+/* -------------------------------------------------------------------------- */
+/*                                  Interface                                 */
+/* -------------------------------------------------------------------------- */
+type GormInterface interface {
+	CreateReport(ctx *gin.Context, report tables.Reports) (tables.Reports, error)
+	GetReportByPID(ctx *gin.Context, pid string) (tables.Reports, error)
+	GetReportsByStatus(ctx *gin.Context, status string) ([]tables.Reports, error)
+	UpdateReport(ctx *gin.Context, report tables.Reports, fromStatus string, fromClaimedBy string) (tables.Reports, error)
+	Transaction(ctx *gin.Context, fn func(tx *gorm.DB) error) error
+	WithTx(tx *gorm.DB) GormInterface
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                   Handler                                  */
+/* -------------------------------------------------------------------------- */
+func Gorm(gormDB *gorm.DB) *reportsGormImpl {
+	return &reportsGormImpl{
+		DB: gormDB,
+	}
+}
+
+type reportsGormImpl struct {
+	DB *gorm.DB
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                   Methods                                  */
+/* -------------------------------------------------------------------------- */
+
+func (r *reportsGormImpl) CreateReport(ctx *gin.Context, report tables.Reports) (tables.Reports, error) {
+	report.PID = utils.UUIDWithPrefix(constants.Prefix.REPORT)
+
+	err := r.DB.Session(&gorm.Session{}).Create(&report).Error
+	if err != nil {
+		return report, errors.Wrap(err, "[reportsGormImpl][CreateReport]")
+	}
+	return report, nil
+}
+
+func (r *reportsGormImpl) GetReportByPID(ctx *gin.Context, pid string) (tables.Reports, error) {
+	var report tables.Reports
+
+	err := r.DB.Session(&gorm.Session{}).Where("report_pid = ?", pid).
+		Scopes(dbops.DeletedScopes(ctx)).
+		Scopes(dbops.SandboxScopes(ctx)).
+		Take(&report).Error
+
+	if err != nil {
+		return report, errors.Wrap(err, "[reportsGormImpl][GetReportByPID]")
+	}
+	return report, nil
+}
+
+// GetReportsByStatus returns the review queue oldest first
+func (r *reportsGormImpl) GetReportsByStatus(ctx *gin.Context, status string) ([]tables.Reports, error) {
+	var reports []tables.Reports
+
+	err := r.DB.Session(&gorm.Session{}).Where("status = ?", status).
+		Scopes(dbops.DeletedScopes(ctx)).
+		Scopes(dbops.SandboxScopes(ctx)).
+		Order("created_at ASC").
+		Find(&reports).Error
+
+	if err != nil {
+		return reports, errors.Wrap(err, "[reportsGormImpl][GetReportsByStatus]")
+	}
+	return reports, nil
+}
+
+// UpdateReport only applies while the report still has the status and claimant
+// the caller read. ErrReportChanged means another admin changed it first.
+func (r *reportsGormImpl) UpdateReport(ctx *gin.Context, report tables.Reports, fromStatus string, fromClaimedBy string) (tables.Reports, error) {
+	result := r.DB.Session(&gorm.Session{}).Where("report_pid = ?", report.PID).
+		Where("status = ?", fromStatus).
+		Where("COALESCE(claimed_by, '') = ?", fromClaimedBy).
+		Scopes(dbops.DeletedScopes(ctx)).
+		Updates(&report)
+
+	if result.Error != nil {
+		return report, errors.Wrap(result.Error, "[reportsGormImpl][UpdateReport]")
+	}
+	if result.RowsAffected == 0 {
+		return report, ErrReportChanged
+	}
+	return report, nil
+}
+
+// Transaction commits everything fn does through WithTx(tx), or none of it
+func (r *reportsGormImpl) Transaction(ctx *gin.Context, fn func(tx *gorm.DB) error) error {
+	return r.DB.Session(&gorm.Session{}).Transaction(fn)
+}
+
+// WithTx runs the methods inside an open transaction
+func (r *reportsGormImpl) WithTx(tx *gorm.DB) GormInterface {
+	return Gorm(tx)
+}
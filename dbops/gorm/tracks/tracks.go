@@ -0,0 +1,64 @@
+package tracks
+
+import (
+	"github.com/BearTS/go-gin-monolith/database/tables"
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+/* -------------------------------------------------------------------------- */
+/*                                  Interface                                 */
+/* -------------------------------------------------------------------------- */
+type GormInterface interface {
+	GetTracksBySpotifyIDs(ctx *gin.Context, spotifyIDs []string) ([]tables.Tracks, error)
+	UpsertTracks(ctx *gin.Context, tracks []tables.Tracks) error
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                   Handler                                  */
+/* -------------------------------------------------------------------------- */
+func Gorm(gormDB *gorm.DB) *tracksGormImpl {
+	return &tracksGormImpl{
+		DB: gormDB,
+	}
+}
+
+type tracksGormImpl struct {
+	DB *gorm.DB
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                   Methods                                  */
+/* -------------------------------------------------------------------------- */
+
+// catalog rows are shared by everyone, so no sandbox or user scopes apply
+
+func (r *tracksGormImpl) GetTracksBySpotifyIDs(ctx *gin.Context, spotifyIDs []string) ([]tables.Tracks, error) {
+	var tracks []tables.Tracks
+
+	err := r.DB.Session(&gorm.Session{}).Where("spotify_id IN ?", spotifyIDs).
+		Find(&tracks).Error
+
+	if err != nil {
+		return tracks, errors.Wrap(err, "[tracksGormImpl][GetTracksBySpotifyIDs]")
+	}
+	return tracks, nil
+}
+
+func (r *tracksGormImpl) UpsertTracks(ctx *gin.Context, tracks []tables.Tracks) error {
+	if len(tracks) == 0 {
+		return nil
+	}
+
+	err := r.DB.Session(&gorm.Session{}).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "spotify_id"}},
+		UpdateAll: true,
+	}).Create(&tracks).Error
+
+	if err != nil {
+		return errors.Wrap(err, "[tracksGormImpl][UpsertTracks]")
+	}
+	return nil
+}
@@ -0,0 +1,49 @@
+package tracks
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/BearTS/go-gin-monolith/database/tables"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+func TestUpsertTracksWritesImagesAsOneValue(t *testing.T) {
+	db, err := gorm.Open(postgres.New(postgres.Config{DSN: "host=localhost"}), &gorm.Config{
+		DryRun:                 true,
+		SkipDefaultTransaction: true,
+		DisableAutomaticPing:   true,
+	})
+	require.NoError(t, err)
+
+	var stmt *gorm.Statement
+	db.Callback().Create().After("gorm:create").Register("test:capture", func(tx *gorm.DB) {
+		stmt = tx.Statement
+	})
+
+	track := tables.Tracks{
+		SpotifyID: "4uLU6hMCjMI75M1A2tKUQC",
+		Name:      "Never Gonna Give You Up",
+		URI:       "spotify:track:4uLU6hMCjMI75M1A2tKUQC",
+		Images: tables.JSONB{
+			map[string]interface{}{"url": "https://i.scdn.co/a.jpg", "width": 640, "height": 640},
+			map[string]interface{}{"url": "https://i.scdn.co/b.jpg", "width": 300, "height": 300},
+		},
+	}
+	err = Gorm(db).UpsertTracks(nil, []tables.Tracks{track})
+	require.NoError(t, err)
+	require.NotNil(t, stmt)
+
+	// one placeholder per column, the images must not be expanded into a row
+	sql := stmt.SQL.String()
+	columns := strings.Count(sql[:strings.Index(sql, "VALUES")], ",") + 1
+	values := sql[strings.Index(sql, "VALUES"):strings.Index(sql, "ON CONFLICT")]
+	assert.Equal(t, columns, strings.Count(values, "$"), sql)
+
+	images, err := track.Images.Value()
+	require.NoError(t, err)
+	assert.JSONEq(t, `[{"url":"https://i.scdn.co/a.jpg","width":640,"height":640},{"url":"https://i.scdn.co/b.jpg","width":300,"height":300}]`, string(images.([]byte)))
+}
@@ -19,6 +19,8 @@ type GormInterface interface {
 	UpdateUser(ctx *gin.Context, user tables.Users) (tables.Users, error)
 	GetUserDetails(ctx *gin.Context) (tables.Users, error)
 	GetUserDetailsByEmail(ctx *gin.Context, email string) (tables.Users, error)
+	SuspendUser(ctx *gin.Context, PID string) error
+	WithTx(tx *gorm.DB) GormInterface
 }
 
 /* -------------------------------------------------------------------------- */
@@ -119,3 +121,27 @@ func (r *usersGormImpl) GetUserDetailsByEmail(ctx *gin.Context, email string) (t
 	}
 	return user, err
 }
+
+// SuspendUser is used by admins, so it is not scoped to the caller's user_pid.
+// gorm.ErrRecordNotFound means there is no such user.
+func (r *usersGormImpl) SuspendUser(ctx *gin.Context, PID string) error {
+	db := r.DB.Session(&gorm.Session{})
+	result := db.Model(&tables.Users{}).
+		Where("user_pid = ?", PID).
+		Scopes(dbops.DeletedScopes(ctx)).
+		Update("is_suspended", true)
+
+	err := result.Error
+	if err != nil {
+		return errors.Wrap(err, "[usersGormImpl][SuspendUser]")
+	}
+	if result.RowsAffected == 0 {
+		return errors.Wrap(gorm.ErrRecordNotFound, "[usersGormImpl][SuspendUser]")
+	}
+	return err
+}
+
+// WithTx runs the methods inside an open transaction
+func (r *usersGormImpl) WithTx(tx *gorm.DB) GormInterface {
+	return Gorm(tx)
+}
@@ -0,0 +1,39 @@
+package dbops
+
+import (
+	"github.com/BearTS/go-gin-monolith/utils"
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// RebalanceRanks gives every row of a list fresh, evenly spaced rank keys in
+// its current RankScopes order. db must select the list, e.g.
+//
+//	err := dbops.RebalanceRanks(ctx,
+//		r.DB.Session(&gorm.Session{}).Model(&tables.QueueItems{}).Where("room_pid = ?", roomPID),
+//		"queue_item_pid")
+//
+// Run it when a move produced a key for which utils.NeedsRebalance is true, or
+// when utils.RankBetween returned utils.ErrRankCollision. The rows stay locked
+// until the new keys are written, so concurrent moves wait for it.
+func RebalanceRanks(ctx *gin.Context, db *gorm.DB, pidColumn string) error {
+	return db.Transaction(func(tx *gorm.DB) error {
+		var pids []string
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Scopes(RankScopes(ctx, pidColumn)).
+			Pluck(pidColumn, &pids).Error
+		if err != nil {
+			return err
+		}
+
+		keys := utils.RankKeys(len(pids))
+		for i, pid := range pids {
+			err = tx.Where(pidColumn+" = ?", pid).Update("rank_key", keys[i]).Error
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
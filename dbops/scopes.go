@@ -63,3 +63,12 @@ func RunnerScopes(c *gin.Context) func(db *gorm.DB) *gorm.DB {
 		return db.Where("runner_pid = ?", authData.UserPID)
 	}
 }
+
+// RankScopes orders rows by their rank_key, breaking ties by the pid column
+// (e.g. queue_item_pid) so that items dropped on the same spot concurrently
+// sort the same way everywhere
+func RankScopes(c *gin.Context, pidColumn string) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Order("rank_key ASC").Order(pidColumn + " ASC")
+	}
+}
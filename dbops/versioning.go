@@ -0,0 +1,55 @@
+package dbops
+
+import (
+	"errors"
+
+	"gorm.io/gorm"
+)
+
+// ErrStaleVersion means the row changed since the caller read it
+var ErrStaleVersion = errors.New("stale version")
+
+// Optimistic concurrency for any table with a
+// `Version int gorm:"column:version;not null;default:1"` column.
+//
+// UpdateWithVersion applies updates only while the row is still at version and
+// bumps the version in the same statement. db must already select the row, e.g.
+//
+//	newVersion, err := dbops.UpdateWithVersion(
+//		r.DB.Session(&gorm.Session{}).Model(&tables.Playlists{}).Where("playlist_pid = ?", pid),
+//		version, map[string]interface{}{"name": name})
+//
+// ErrStaleVersion is returned when the row exists at another version, the
+// caller should reload it and answer 412 with it. gorm.ErrRecordNotFound is
+// returned when there is no such row, which is a 404.
+//
+// An `If-Match: *` (utils.IfMatchAny) has no version to check, callers update
+// the row without this helper.
+func UpdateWithVersion(db *gorm.DB, version int, updates map[string]interface{}) (int, error) {
+	values := map[string]interface{}{}
+	for column, value := range updates {
+		values[column] = value
+	}
+	values["version"] = gorm.Expr("version + 1")
+
+	// a session so the row selection can be reused below
+	tx := db.Session(&gorm.Session{})
+
+	result := tx.Where("version = ?", version).Updates(values)
+	if result.Error != nil {
+		return version, result.Error
+	}
+	if result.RowsAffected > 0 {
+		return version + 1, nil
+	}
+
+	var count int64
+	err := tx.Count(&count).Error
+	if err != nil {
+		return version, err
+	}
+	if count == 0 {
+		return version, gorm.ErrRecordNotFound
+	}
+	return version, ErrStaleVersion
+}
@@ -0,0 +1,79 @@
+package dbops
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+type versionedRow struct {
+	ID      int    `gorm:"column:row_id;primaryKey"`
+	PID     string `gorm:"column:row_pid"`
+	Name    string `gorm:"column:name"`
+	Version int    `gorm:"column:version"`
+}
+
+// dryRunDB fakes the outcome of the update and of the existence check, and
+// records the SQL that would have run
+func dryRunDB(t *testing.T, rowsUpdated int64, rowsFound int64) (*gorm.DB, *[]string) {
+	db, err := gorm.Open(postgres.New(postgres.Config{DSN: "host=localhost"}), &gorm.Config{
+		DryRun:                 true,
+		SkipDefaultTransaction: true,
+		DisableAutomaticPing:   true,
+	})
+	require.NoError(t, err)
+
+	var statements []string
+	db.Callback().Update().After("gorm:update").Register("test:update", func(tx *gorm.DB) {
+		tx.RowsAffected = rowsUpdated
+		statements = append(statements, tx.Statement.SQL.String())
+	})
+	db.Callback().Query().After("gorm:query").Register("test:query", func(tx *gorm.DB) {
+		if count, ok := tx.Statement.Dest.(*int64); ok {
+			*count = rowsFound
+			tx.RowsAffected = 1 // a count query returns one row
+		}
+		statements = append(statements, tx.Statement.SQL.String())
+	})
+	return db, &statements
+}
+
+func selectRow(db *gorm.DB) *gorm.DB {
+	return db.Session(&gorm.Session{}).Model(&versionedRow{}).Where("row_pid = ?", "row_1")
+}
+
+func TestUpdateWithVersion(t *testing.T) {
+	db, statements := dryRunDB(t, 1, 1)
+
+	version, err := UpdateWithVersion(selectRow(db), 3, map[string]interface{}{"name": "new"})
+	require.NoError(t, err)
+	assert.Equal(t, 4, version)
+
+	require.Len(t, *statements, 1)
+	assert.Contains(t, (*statements)[0], `"version"=version + 1`)
+	assert.Contains(t, (*statements)[0], `row_pid = $`)
+	assert.Contains(t, (*statements)[0], `version = $`)
+}
+
+func TestUpdateWithVersionStale(t *testing.T) {
+	db, statements := dryRunDB(t, 0, 1)
+
+	version, err := UpdateWithVersion(selectRow(db), 3, map[string]interface{}{"name": "new"})
+	assert.ErrorIs(t, err, ErrStaleVersion)
+	assert.Equal(t, 3, version)
+
+	// the existence check selects the same row, without the version
+	require.Len(t, *statements, 2)
+	assert.Contains(t, (*statements)[1], `row_pid = $`)
+	assert.NotContains(t, (*statements)[1], `version = $`)
+}
+
+func TestUpdateWithVersionNotFound(t *testing.T) {
+	db, _ := dryRunDB(t, 0, 0)
+
+	_, err := UpdateWithVersion(selectRow(db), 3, map[string]interface{}{"name": "new"})
+	assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+}
@@ -1,19 +1,25 @@
 package merrors
 
 var errorType = struct {
-	validation         string
-	server             string
-	Unauthorized       string
-	conflict           string
-	ServiceUnavailable string
-	Forbidden          string
-	Downstream         string
+	validation           string
+	server               string
+	Unauthorized         string
+	conflict             string
+	ServiceUnavailable   string
+	Forbidden            string
+	Downstream           string
+	PreconditionFailed   string
+	PreconditionRequired string
+	NotFound             string
 }{
-	validation:         "validation",
-	server:             "server",
-	Unauthorized:       "unauthorized",
-	conflict:           "conflict",
-	ServiceUnavailable: "service unavailable",
-	Forbidden:          "forbidden",
-	Downstream:         "downstream",
+	validation:           "validation",
+	server:               "server",
+	Unauthorized:         "unauthorized",
+	conflict:             "conflict",
+	ServiceUnavailable:   "service unavailable",
+	Forbidden:            "forbidden",
+	Downstream:           "downstream",
+	PreconditionFailed:   "precondition failed",
+	PreconditionRequired: "precondition required",
+	NotFound:             "not found",
 }
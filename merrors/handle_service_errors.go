@@ -21,10 +21,22 @@ func HandleServiceCodes(ctx *gin.Context, baseRes utils.BaseResponse) {
 		{
 			ServiceUnavailable(ctx, baseRes.Message)
 		}
+	case http.StatusNotFound:
+		{
+			NotFound(ctx, baseRes.Message)
+		}
 	case http.StatusConflict:
 		{
 			Conflict(ctx, baseRes.Message)
 		}
+	case http.StatusPreconditionFailed:
+		{
+			PreconditionFailed(ctx, baseRes.Message, baseRes.Data)
+		}
+	case http.StatusPreconditionRequired:
+		{
+			PreconditionRequired(ctx, baseRes.Message)
+		}
 	case http.StatusUnprocessableEntity:
 		{
 			Validation(ctx, baseRes.Message)
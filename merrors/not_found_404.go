@@ -0,0 +1,26 @@
+package merrors
+
+import (
+	"net/http"
+
+	"github.com/BearTS/go-gin-monolith/utils"
+	"github.com/gin-gonic/gin"
+)
+
+/* -------------------------------------------------------------------------- */
+/*                                NOT FOUND 404                               */
+/* -------------------------------------------------------------------------- */
+func NotFound(ctx *gin.Context, err string) {
+	var res utils.BaseResponse
+	var smerror Error
+	errorCode := http.StatusNotFound
+
+	smerror.Code = errorCode
+	smerror.Type = errorType.NotFound
+	smerror.Message = err
+
+	res.Error = smerror
+
+	ctx.JSON(errorCode, res)
+	ctx.Abort()
+}
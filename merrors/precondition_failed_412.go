@@ -0,0 +1,30 @@
+package merrors
+
+import (
+	"net/http"
+
+	"github.com/BearTS/go-gin-monolith/utils"
+	"github.com/gin-gonic/gin"
+)
+
+/* -------------------------------------------------------------------------- */
+/*                           PRECONDITION FAILED 412                          */
+/* -------------------------------------------------------------------------- */
+
+// PreconditionFailed answers a stale If-Match with the current state of the
+// resource, so the client can rebase its change without another read
+func PreconditionFailed(ctx *gin.Context, err string, current interface{}) {
+	var res utils.BaseResponse
+	var smerror Error
+	errorCode := http.StatusPreconditionFailed
+
+	smerror.Code = errorCode
+	smerror.Type = errorType.PreconditionFailed
+	smerror.Message = err
+
+	res.Error = smerror
+	res.Data = current
+
+	ctx.JSON(errorCode, res)
+	ctx.Abort()
+}
@@ -0,0 +1,42 @@
+package merrors
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/BearTS/go-gin-monolith/utils"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPreconditionFailedReturnsCurrentState(t *testing.T) {
+	recorder := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(recorder)
+
+	PreconditionFailed(ctx, "resource has changed", gin.H{"name": "current", "version": 4})
+
+	assert.Equal(t, http.StatusPreconditionFailed, recorder.Code)
+	assert.True(t, ctx.IsAborted())
+	assert.JSONEq(t, `{
+		"data": {"name": "current", "version": 4},
+		"error": {"code": 412, "type": "precondition failed", "message": "resource has changed"}
+	}`, recorder.Body.String())
+}
+
+func TestHandleServiceCodesPreconditionFailed(t *testing.T) {
+	recorder := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(recorder)
+
+	var baseRes utils.BaseResponse
+	baseRes.StatusCode = http.StatusPreconditionFailed
+	baseRes.Message = "resource has changed"
+	baseRes.Data = gin.H{"version": 4}
+	HandleServiceCodes(ctx, baseRes)
+
+	assert.Equal(t, http.StatusPreconditionFailed, recorder.Code)
+	assert.JSONEq(t, `{
+		"data": {"version": 4},
+		"error": {"code": 412, "type": "precondition failed", "message": "resource has changed"}
+	}`, recorder.Body.String())
+}
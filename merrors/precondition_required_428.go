@@ -0,0 +1,26 @@
+package merrors
+
+import (
+	"net/http"
+
+	"github.com/BearTS/go-gin-monolith/utils"
+	"github.com/gin-gonic/gin"
+)
+
+/* -------------------------------------------------------------------------- */
+/*                          PRECONDITION REQUIRED 428                         */
+/* -------------------------------------------------------------------------- */
+func PreconditionRequired(ctx *gin.Context, err string) {
+	var res utils.BaseResponse
+	var smerror Error
+	errorCode := http.StatusPreconditionRequired
+
+	smerror.Code = errorCode
+	smerror.Type = errorType.PreconditionRequired
+	smerror.Message = err
+
+	res.Error = smerror
+
+	ctx.JSON(errorCode, res)
+	ctx.Abort()
+}
@@ -6,6 +6,8 @@ type AuthData struct {
 	SessionPID string `json:"session_pid" binding:"required"`
 	UserPID    string `json:"user_pid" binding:"required"`
 	AdminPID   string `json:"admin_pid" binding:"required"`
+	GuestPID   string `json:"guest_pid"`
+	RoomPID    string `json:"room_pid"` // only set for guest tokens
 	Sandbox    bool   `json:"sandbox" binding:"required"`
 	Type       string `json:"type" binding:"required"`
 	jwt.RegisteredClaims
@@ -2,15 +2,21 @@ package authsvc
 
 import (
 	"net/http"
+	"time"
 
+	"github.com/BearTS/go-gin-monolith/config"
 	"github.com/BearTS/go-gin-monolith/constants"
 	"github.com/BearTS/go-gin-monolith/database"
+	"github.com/BearTS/go-gin-monolith/database/tables"
 	"github.com/BearTS/go-gin-monolith/dbops/gorm/admin"
 	"github.com/BearTS/go-gin-monolith/dbops/gorm/users"
 	"github.com/BearTS/go-gin-monolith/models"
+	"github.com/BearTS/go-gin-monolith/services/moderationsvc"
 	"github.com/BearTS/go-gin-monolith/utils"
 	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v4"
 	"github.com/pkg/errors"
+	"gorm.io/gorm"
 )
 
 func (g *authSvcImpl) GenerateToken(c *gin.Context, req TokenReq) (utils.BaseResponse, TokenRes, error) {
@@ -36,6 +42,14 @@ func (g *authSvcImpl) GenerateToken(c *gin.Context, req TokenReq) (utils.BaseRes
 			}
 			return baseRes, res, err
 		}
+	case constants.TokenTypes.GUEST:
+		{
+			baseRes, res, err := g.guestTokenGeneration(c, req)
+			if err != nil {
+				return baseRes, res, errors.Wrap(err, "[GenerateToken][guestTokenGeneration]")
+			}
+			return baseRes, res, err
+		}
 	default:
 		{
 			baseRes.Success = false
@@ -61,6 +75,13 @@ func (g *authSvcImpl) userTokenGeneration(c *gin.Context, req TokenReq) (utils.B
 	if err != nil {
 		return baseRes, res, errors.Wrap(err, "[onboardingTokenGeneration][GetUserDetailsByPID]")
 	}
+
+	if userData.IsSuspended {
+		baseRes.Success = false
+		baseRes.Message = "user is suspended"
+		baseRes.StatusCode = http.StatusForbidden
+		return baseRes, res, err
+	}
 	authData.Sandbox = userData.IsSandbox
 	authData.UserPID = userData.PID
 
@@ -124,3 +145,115 @@ func (g *authSvcImpl) adminTokenGeneration(c *gin.Context, req TokenReq) (utils.
 
 	return baseRes, res, err
 }
+
+// defaultGuestTTL applies when TOKEN_GUEST_TTL_HOURS is not set
+const defaultGuestTTL = 12 * time.Hour
+
+// guestTokenGeneration issues a token scoped to a single room for someone who
+// joined by share code with only a display name. How long the guest may stay is
+// decided here, never by the caller: there are no rooms to take an end time
+// from yet, so it is the configured guest TTL.
+func (g *authSvcImpl) guestTokenGeneration(c *gin.Context, req TokenReq) (utils.BaseResponse, TokenRes, error) {
+	var baseRes utils.BaseResponse
+	var res TokenRes
+	var err error
+
+	if req.RoomID == "" || req.DisplayName == "" {
+		baseRes.Success = false
+		baseRes.Message = "room and display name are required for guest tokens"
+		baseRes.StatusCode = http.StatusUnprocessableEntity
+		return baseRes, res, err
+	}
+
+	// display names are shown to the whole room
+	moderationBaseRes, _, err := g.moderationSvc.Moderate(c, moderationsvc.ModerateReq{
+		Text:   req.DisplayName,
+		Action: constants.ModerationActions.REJECT,
+		Source: "display_name",
+	})
+	if err != nil {
+		return baseRes, res, errors.Wrap(err, "[guestTokenGeneration][Moderate]")
+	}
+	if moderationBaseRes.StatusCode != http.StatusOK {
+		return moderationBaseRes, res, err
+	}
+
+	var guest tables.Guests
+	guest.RoomPID = req.RoomID
+	guest.DisplayName = req.DisplayName
+	guest.ExpiresAt = time.Now().Add(guestTTL())
+
+	guestData, err := g.guestsGorm.CreateGuest(c, guest)
+	if err != nil {
+		return baseRes, res, errors.Wrap(err, "[guestTokenGeneration][CreateGuest]")
+	}
+
+	return g.guestToken(guestData)
+}
+
+// guestTokenRefresh issues fresh tokens to an existing guest, capped at the
+// expiry stored on the guest row
+func (g *authSvcImpl) guestTokenRefresh(c *gin.Context, guestPID string) (utils.BaseResponse, TokenRes, error) {
+	var baseRes utils.BaseResponse
+	var res TokenRes
+
+	guestData, err := g.guestsGorm.GetGuestByPID(c, guestPID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			baseRes.Success = false
+			baseRes.Message = "guest not found"
+			baseRes.StatusCode = http.StatusUnauthorized
+			return baseRes, res, nil
+		}
+		return baseRes, res, errors.Wrap(err, "[guestTokenRefresh][GetGuestByPID]")
+	}
+
+	if !guestData.ExpiresAt.After(time.Now()) {
+		baseRes.Success = false
+		baseRes.Message = "guest session has ended"
+		baseRes.StatusCode = http.StatusForbidden
+		return baseRes, res, nil
+	}
+
+	return g.guestToken(guestData)
+}
+
+func (g *authSvcImpl) guestToken(guestData tables.Guests) (utils.BaseResponse, TokenRes, error) {
+	var baseRes utils.BaseResponse
+	var res TokenRes
+	var authData models.AuthData
+
+	authData.Type = constants.TokenTypes.GUEST
+	authData.Sandbox = guestData.IsSandbox
+	authData.GuestPID = guestData.PID
+	authData.RoomPID = guestData.RoomPID
+	authData.ExpiresAt = jwt.NewNumericDate(guestData.ExpiresAt)
+
+	tokenRes, err := g.CreateToken(authData)
+	if err != nil {
+		return baseRes, res, errors.Wrap(err, "[guestToken][CreateToken]")
+	}
+
+	res.AccesssToken = tokenRes.AccessToken
+	res.RefreshToken = tokenRes.RefreshToken
+	res.AccessTokenExp = tokenRes.AtExpires
+	res.RefreshTokenExp = tokenRes.RtExpires
+	res.AccesssTokenPID = tokenRes.TokenUuid
+	res.RefreshTokenPID = tokenRes.RefreshUuid
+	res.Type = authData.Type
+	res.GuestID = guestData.PID
+	res.RoomID = guestData.RoomPID
+
+	baseRes.Success = true
+	baseRes.Message = "token generated successfully"
+	baseRes.StatusCode = http.StatusOK
+
+	return baseRes, res, nil
+}
+
+func guestTTL() time.Duration {
+	if config.Token.GuestTtlHours > 0 {
+		return time.Duration(config.Token.GuestTtlHours) * time.Hour
+	}
+	return defaultGuestTTL
+}
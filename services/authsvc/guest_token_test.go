@@ -0,0 +1,151 @@
+package authsvc
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/BearTS/go-gin-monolith/config"
+	"github.com/BearTS/go-gin-monolith/constants"
+	"github.com/BearTS/go-gin-monolith/database/tables"
+	"github.com/BearTS/go-gin-monolith/dbops/gorm/guests"
+	"github.com/BearTS/go-gin-monolith/models"
+	"github.com/BearTS/go-gin-monolith/services/moderationsvc"
+	"github.com/BearTS/go-gin-monolith/utils"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+/* -------------------------------------------------------------------------- */
+/*                                    Fakes                                   */
+/* -------------------------------------------------------------------------- */
+
+type fakeGuestsGorm struct {
+	guests map[string]tables.Guests
+}
+
+func (f *fakeGuestsGorm) CreateGuest(ctx *gin.Context, guest tables.Guests) (tables.Guests, error) {
+	guest.PID = utils.UUIDWithPrefix(constants.Prefix.GUEST)
+	f.guests[guest.PID] = guest
+	return guest, nil
+}
+
+func (f *fakeGuestsGorm) GetGuestByPID(ctx *gin.Context, pid string) (tables.Guests, error) {
+	guest, ok := f.guests[pid]
+	if !ok || guest.IsDeleted {
+		return guest, gorm.ErrRecordNotFound
+	}
+	return guest, nil
+}
+
+func (f *fakeGuestsGorm) LinkUser(ctx *gin.Context, guestPID string, userPID string) error {
+	return nil
+}
+
+func (f *fakeGuestsGorm) RevokeGuest(ctx *gin.Context, pid string) error {
+	return nil
+}
+
+func (f *fakeGuestsGorm) WithTx(tx *gorm.DB) guests.GormInterface {
+	return f
+}
+
+func newTestAuthSvc(t *testing.T) (*authSvcImpl, *fakeGuestsGorm, *gin.Context) {
+	config.Token = &config.TokenConfig{AccessSecret: "test-access", RefreshSecret: "test-refresh", GuestTtlHours: 2}
+	config.Moderation = &config.ModerationConfig{WordList: "darn"}
+
+	guestsGorm := &fakeGuestsGorm{guests: map[string]tables.Guests{}}
+	ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+	ctx.Request = httptest.NewRequest(http.MethodPost, "/", nil)
+
+	return Handler(guestsGorm, moderationsvc.Handler(nil, nil)), guestsGorm, ctx
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                    Tests                                   */
+/* -------------------------------------------------------------------------- */
+
+func TestGuestTokenGeneration(t *testing.T) {
+	svc, guestsGorm, ctx := newTestAuthSvc(t)
+
+	baseRes, res, err := svc.GenerateToken(ctx, TokenReq{
+		Type:        constants.TokenTypes.GUEST,
+		RoomID:      "rom_1",
+		DisplayName: "dj jazzy",
+	})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, baseRes.StatusCode, baseRes.Message)
+
+	// the guest may stay for the configured TTL, whatever the caller asked for
+	guest, ok := guestsGorm.guests[res.GuestID]
+	require.True(t, ok)
+	assert.Equal(t, "rom_1", guest.RoomPID)
+	assert.WithinDuration(t, time.Now().Add(2*time.Hour), guest.ExpiresAt, time.Minute)
+
+	authData, err := utils.GetAuthDataFromToken(res.AccesssToken)
+	require.NoError(t, err)
+	assert.Equal(t, constants.TokenTypes.GUEST, authData.Type)
+	assert.Equal(t, res.GuestID, authData.GuestPID)
+	assert.Equal(t, "rom_1", authData.RoomPID)
+	assert.Empty(t, authData.UserPID)
+
+	refreshData, err := utils.GetRefreshTokenDataFromToken(res.RefreshToken)
+	require.NoError(t, err)
+	assert.Equal(t, res.GuestID, refreshData.GuestPID)
+	assert.False(t, refreshData.ExpiresAt.Time.After(guest.ExpiresAt))
+}
+
+func TestGuestTokenGenerationValidation(t *testing.T) {
+	svc, guestsGorm, ctx := newTestAuthSvc(t)
+
+	baseRes, _, err := svc.GenerateToken(ctx, TokenReq{Type: constants.TokenTypes.GUEST, DisplayName: "dj jazzy"})
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusUnprocessableEntity, baseRes.StatusCode)
+
+	baseRes, _, err = svc.GenerateToken(ctx, TokenReq{Type: constants.TokenTypes.GUEST, RoomID: "rom_1", DisplayName: "d4rn it"})
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusUnprocessableEntity, baseRes.StatusCode)
+
+	assert.Empty(t, guestsGorm.guests)
+}
+
+func TestGuestTokenRefreshIsCappedAtGuestExpiry(t *testing.T) {
+	svc, guestsGorm, ctx := newTestAuthSvc(t)
+
+	expiresAt := time.Now().Add(10 * time.Minute).Truncate(time.Second)
+	guestsGorm.guests["gst_1"] = tables.Guests{PID: "gst_1", RoomPID: "rom_1", ExpiresAt: expiresAt}
+
+	baseRes, res, err := svc.RefreshToken(ctx, models.AuthData{GuestPID: "gst_1"})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, baseRes.StatusCode, baseRes.Message)
+
+	// the access token would last 30 minutes, the guest row only 10
+	assert.Equal(t, expiresAt.Unix(), res.AccessTokenExp)
+	assert.Equal(t, expiresAt.Unix(), res.RefreshTokenExp)
+	assert.Equal(t, "gst_1", res.GuestID)
+}
+
+func TestGuestTokenRefreshRejected(t *testing.T) {
+	svc, guestsGorm, ctx := newTestAuthSvc(t)
+
+	guestsGorm.guests["gst_ended"] = tables.Guests{PID: "gst_ended", RoomPID: "rom_1", ExpiresAt: time.Now().Add(-time.Minute)}
+	guestsGorm.guests["gst_revoked"] = tables.Guests{PID: "gst_revoked", RoomPID: "rom_1", ExpiresAt: time.Now().Add(time.Hour), IsDeleted: true}
+
+	cases := map[string]int{
+		"gst_ended":   http.StatusForbidden,
+		"gst_revoked": http.StatusUnauthorized,
+		"gst_unknown": http.StatusUnauthorized,
+	}
+	for guestPID, status := range cases {
+		baseRes, _, err := svc.RefreshToken(ctx, models.AuthData{GuestPID: guestPID})
+		require.NoError(t, err, guestPID)
+		assert.Equal(t, status, baseRes.StatusCode, guestPID)
+	}
+
+	baseRes, _, err := svc.RefreshToken(ctx, models.AuthData{})
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusUnauthorized, baseRes.StatusCode)
+}
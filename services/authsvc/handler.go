@@ -1,16 +1,22 @@
 package authsvc
 
 import (
+	"github.com/BearTS/go-gin-monolith/dbops/gorm/guests"
 	"github.com/BearTS/go-gin-monolith/models"
+	"github.com/BearTS/go-gin-monolith/services/moderationsvc"
 	"github.com/BearTS/go-gin-monolith/utils"
 	"github.com/gin-gonic/gin"
 )
 
-type authSvcImpl struct{}
+type authSvcImpl struct {
+	guestsGorm    guests.GormInterface
+	moderationSvc moderationsvc.Interface
+}
 
 // interface.
 type Interface interface {
 	GenerateToken(c *gin.Context, req TokenReq) (utils.BaseResponse, TokenRes, error)
+	RefreshToken(c *gin.Context, refreshData models.AuthData) (utils.BaseResponse, TokenRes, error)
 	CreateToken(tokenAuthData models.AuthData) (*TokenDetails, error)
 	ValidateToken(signedToken string) error
 }
@@ -20,6 +26,9 @@ type Interface interface {
 /* -------------------------------------------------------------------------- */
 /* ----------------------------------- sd ----------------------------------- */
 
-func Handler() *authSvcImpl {
-	return &authSvcImpl{}
+func Handler(guestsGorm guests.GormInterface, moderationSvc moderationsvc.Interface) *authSvcImpl {
+	return &authSvcImpl{
+		guestsGorm:    guestsGorm,
+		moderationSvc: moderationSvc,
+	}
 }
@@ -16,7 +16,9 @@ package authsvc
 type TokenRes struct {
 	Type             string       `json:"type"`
 	UserID           string       `json:"user_id,omitempty"`
-	AdminID         string       `json:"admin_id,omitempty"`
+	AdminID          string       `json:"admin_id,omitempty"`
+	GuestID          string       `json:"guest_id,omitempty"`
+	RoomID           string       `json:"room_id,omitempty"`
 	IsPhoneAvailable bool         `json:"is_phone_available,omitempty"`
 	Metadata         *interface{} `json:"metadata"`
 	AccesssTokenPID  string       `json:"access_token_pid"`
@@ -54,4 +56,8 @@ type TokenReq struct {
 	Type     string      `json:"type"`
 	UserID   string      `json:"user_id"`
 	Metadata interface{} `json:"metadata"`
+
+	// guest tokens only
+	RoomID      string `json:"room_id"`
+	DisplayName string `json:"display_name"`
 }
@@ -0,0 +1,44 @@
+package authsvc
+
+import (
+	"net/http"
+
+	"github.com/BearTS/go-gin-monolith/constants"
+	"github.com/BearTS/go-gin-monolith/models"
+	"github.com/BearTS/go-gin-monolith/utils"
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+)
+
+// RefreshToken issues a new token pair for whoever a verified refresh token was
+// issued to. Users are re-checked for suspension, guests against their row.
+func (g *authSvcImpl) RefreshToken(c *gin.Context, refreshData models.AuthData) (utils.BaseResponse, TokenRes, error) {
+	var baseRes utils.BaseResponse
+	var res TokenRes
+
+	switch {
+	case refreshData.UserPID != "":
+		var req TokenReq
+		req.Type = constants.TokenTypes.USER
+		req.UserID = refreshData.UserPID
+
+		baseRes, res, err := g.userTokenGeneration(c, req)
+		if err != nil {
+			return baseRes, res, errors.Wrap(err, "[RefreshToken][userTokenGeneration]")
+		}
+		return baseRes, res, err
+
+	case refreshData.GuestPID != "":
+		baseRes, res, err := g.guestTokenRefresh(c, refreshData.GuestPID)
+		if err != nil {
+			return baseRes, res, errors.Wrap(err, "[RefreshToken][guestTokenRefresh]")
+		}
+		return baseRes, res, err
+
+	default:
+		baseRes.Success = false
+		baseRes.Message = "invalid token"
+		baseRes.StatusCode = http.StatusUnauthorized
+		return baseRes, res, nil
+	}
+}
@@ -20,17 +20,27 @@ func (t *authSvcImpl) CreateToken(tokenAuthData models.AuthData) (*TokenDetails,
 	// refresh token expiry
 	rtexp := time.Now().Add(time.Hour * 24 * 7) // expires after 7 days
 
+	// tokens with a hard deadline (guests of a room) never outlive it
+	if tokenAuthData.ExpiresAt != nil {
+		if tokenAuthData.ExpiresAt.Time.Before(atexp) {
+			atexp = tokenAuthData.ExpiresAt.Time
+		}
+		if tokenAuthData.ExpiresAt.Time.Before(rtexp) {
+			rtexp = tokenAuthData.ExpiresAt.Time
+		}
+	}
+
 	// set authdata
 	tokenAuthData.SessionPID = utils.UUIDWithPrefix(constants.Prefix.SESSION)
 	tokenAuthData.RegisteredClaims.Issuer = "sample-issuers"
-	tokenAuthData.RegisteredClaims.IssuedAt = &jwt.NumericDate{time.Now()}
+	tokenAuthData.RegisteredClaims.IssuedAt = jwt.NewNumericDate(time.Now())
 
 	td := &TokenDetails{}
 	td.AtExpires = atexp.Unix()
 	td.TokenUuid = utils.UUIDWithPrefix("tk")
 
 	td.RtExpires = rtexp.Unix()
-	td.RefreshUuid = td.TokenUuid + "++" + tokenAuthData.UserPID
+	td.RefreshUuid = refreshUuid(td.TokenUuid, tokenAuthData)
 
 	//Creating Access Token
 	atClaims := jwt.MapClaims{}
@@ -45,6 +55,9 @@ func (t *authSvcImpl) CreateToken(tokenAuthData models.AuthData) (*TokenDetails,
 		atClaims["admin_pid"] = tokenAuthData.AdminPID
 	} else if tokenAuthData.UserPID != "" {
 		atClaims["user_pid"] = tokenAuthData.UserPID
+	} else if tokenAuthData.GuestPID != "" && tokenAuthData.RoomPID != "" {
+		atClaims["guest_pid"] = tokenAuthData.GuestPID
+		atClaims["room_pid"] = tokenAuthData.RoomPID
 	} else {
 		return nil, errors.New("invalid token auth data")
 	}
@@ -57,18 +70,18 @@ func (t *authSvcImpl) CreateToken(tokenAuthData models.AuthData) (*TokenDetails,
 
 	//Creating Refresh Token
 	td.RtExpires = rtexp.Unix()
-	td.RefreshUuid = td.TokenUuid + "++" + tokenAuthData.UserPID
+	td.RefreshUuid = refreshUuid(td.TokenUuid, tokenAuthData)
 
 	//set auth data
 	tokenAuthData.SessionPID = utils.UUIDWithPrefix(constants.Prefix.SESSION)
 	tokenAuthData.RegisteredClaims.Issuer = "tez"
-	tokenAuthData.RegisteredClaims.IssuedAt = &jwt.NumericDate{time.Now()}
+	tokenAuthData.RegisteredClaims.IssuedAt = jwt.NewNumericDate(time.Now())
 
 	rtClaims := jwt.MapClaims{}
 	rtClaims["refresh_session_pid"] = td.RefreshUuid
 	rtClaims["type"] = "refresh"
 	rtClaims["sandbox"] = tokenAuthData.Sandbox
-	rtClaims["exp"] = td.AtExpires
+	rtClaims["exp"] = td.RtExpires
 	rtClaims["issuer"] = tokenAuthData.RegisteredClaims.Issuer
 	rtClaims["issued_at"] = tokenAuthData.RegisteredClaims.IssuedAt
 
@@ -80,6 +93,11 @@ func (t *authSvcImpl) CreateToken(tokenAuthData models.AuthData) (*TokenDetails,
 		rtClaims["user_pid"] = tokenAuthData.UserPID
 	}
 
+	if tokenAuthData.GuestPID != "" {
+		rtClaims["guest_pid"] = tokenAuthData.GuestPID
+		rtClaims["room_pid"] = tokenAuthData.RoomPID
+	}
+
 	rt := jwt.NewWithClaims(jwt.SigningMethodHS256, rtClaims)
 
 	td.RefreshToken, err = rt.SignedString([]byte(config.Token.RefreshSecret))
@@ -89,7 +107,20 @@ func (t *authSvcImpl) CreateToken(tokenAuthData models.AuthData) (*TokenDetails,
 	return td, nil
 }
 
+// refreshUuid ties a refresh token to whoever it was issued for, guests have no user pid
+func refreshUuid(tokenUuid string, tokenAuthData models.AuthData) string {
+	if tokenAuthData.UserPID == "" && tokenAuthData.GuestPID != "" {
+		return tokenUuid + "++" + tokenAuthData.GuestPID
+	}
+	return tokenUuid + "++" + tokenAuthData.UserPID
+}
+
 func (s *authSvcImpl) ValidateToken(signedToken string) error {
+	return ValidateToken(signedToken)
+}
+
+// ValidateToken checks an access token, it needs none of the service's dependencies
+func ValidateToken(signedToken string) error {
 	accessSecretKey := []byte(config.Token.AccessSecret)
 	token, err := jwt.ParseWithClaims(signedToken, &models.AuthData{}, func(t *jwt.Token) (interface{}, error) {
 		return accessSecretKey, nil
@@ -0,0 +1,49 @@
+package authsvc
+
+import (
+	"testing"
+	"time"
+
+	"github.com/BearTS/go-gin-monolith/config"
+	"github.com/BearTS/go-gin-monolith/constants"
+	"github.com/BearTS/go-gin-monolith/models"
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func refreshClaims(t *testing.T, signed string) jwt.MapClaims {
+	claims := jwt.MapClaims{}
+	_, err := jwt.ParseWithClaims(signed, claims, func(t *jwt.Token) (interface{}, error) {
+		return []byte(config.Token.RefreshSecret), nil
+	})
+	require.NoError(t, err)
+	return claims
+}
+
+func TestCreateTokenRefreshOutlivesAccess(t *testing.T) {
+	config.Token = &config.TokenConfig{AccessSecret: "test-access", RefreshSecret: "test-refresh"}
+
+	td, err := Handler(nil, nil).CreateToken(models.AuthData{Type: constants.TokenTypes.USER, UserPID: "usr_1"})
+	require.NoError(t, err)
+
+	// the refresh token has to outlive the access token it renews
+	claims := refreshClaims(t, td.RefreshToken)
+	assert.Equal(t, float64(td.RtExpires), claims["exp"])
+	assert.InDelta(t, time.Now().Add(7*24*time.Hour).Unix(), td.RtExpires, 5)
+	assert.Greater(t, td.RtExpires, td.AtExpires)
+}
+
+func TestCreateTokenGuestCappedAtExpiry(t *testing.T) {
+	config.Token = &config.TokenConfig{AccessSecret: "test-access", RefreshSecret: "test-refresh"}
+
+	expiresAt := time.Now().Add(10 * time.Minute)
+	authData := models.AuthData{Type: constants.TokenTypes.GUEST, GuestPID: "gst_1", RoomPID: "rom_1"}
+	authData.ExpiresAt = jwt.NewNumericDate(expiresAt)
+	td, err := Handler(nil, nil).CreateToken(authData)
+	require.NoError(t, err)
+
+	assert.Equal(t, expiresAt.Unix(), td.AtExpires)
+	assert.Equal(t, expiresAt.Unix(), td.RtExpires)
+	assert.Equal(t, float64(expiresAt.Unix()), refreshClaims(t, td.RefreshToken)["exp"])
+}
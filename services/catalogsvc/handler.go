@@ -0,0 +1,60 @@
+package catalogsvc
+
+import (
+	"time"
+
+	"github.com/BearTS/go-gin-monolith/config"
+	"github.com/BearTS/go-gin-monolith/database/tables"
+	"github.com/BearTS/go-gin-monolith/dbops/gorm/albums"
+	"github.com/BearTS/go-gin-monolith/dbops/gorm/artists"
+	"github.com/BearTS/go-gin-monolith/dbops/gorm/tracks"
+	"github.com/BearTS/go-gin-monolith/spotify"
+	"github.com/gin-gonic/gin"
+)
+
+const defaultTTL = 24 * time.Hour
+
+type catalogSvcImpl struct {
+	tracksGorm  tracks.GormInterface
+	artistsGorm artists.GormInterface
+	albumsGorm  albums.GormInterface
+	spotify     spotify.Interface
+	ttl         time.Duration
+
+	// one per table, so a track and an album sharing an ID never collapse
+	tracksInFlight  *inFlight
+	artistsInFlight *inFlight
+	albumsInFlight  *inFlight
+	tracksNotFound  *notFound
+	artistsNotFound *notFound
+	albumsNotFound  *notFound
+}
+
+// Interface is a read-through cache of the Spotify catalog. Results come back
+// in the order asked for, IDs Spotify doesn't know are left out.
+type Interface interface {
+	GetTracks(c *gin.Context, spotifyIDs []string) ([]tables.Tracks, error)
+	GetArtists(c *gin.Context, spotifyIDs []string) ([]tables.Artists, error)
+	GetAlbums(c *gin.Context, spotifyIDs []string) ([]tables.Albums, error)
+}
+
+func Handler(tracksGorm tracks.GormInterface, artistsGorm artists.GormInterface, albumsGorm albums.GormInterface, spotifyClient spotify.Interface) Interface {
+	ttl := time.Duration(config.Spotify.CatalogTtlMinutes) * time.Minute
+	if ttl <= 0 {
+		ttl = defaultTTL
+	}
+
+	return &catalogSvcImpl{
+		tracksGorm:      tracksGorm,
+		artistsGorm:     artistsGorm,
+		albumsGorm:      albumsGorm,
+		spotify:         spotifyClient,
+		ttl:             ttl,
+		tracksInFlight:  newInFlight(),
+		artistsInFlight: newInFlight(),
+		albumsInFlight:  newInFlight(),
+		tracksNotFound:  newNotFound(notFoundTTL),
+		artistsNotFound: newNotFound(notFoundTTL),
+		albumsNotFound:  newNotFound(notFoundTTL),
+	}
+}
@@ -0,0 +1,72 @@
+package catalogsvc
+
+import (
+	"errors"
+	"sync"
+)
+
+// errFetchAborted is what waiters see when the owner's fetch never returned
+var errFetchAborted = errors.New("catalog fetch aborted")
+
+// inFlight collapses concurrent lookups of the same ID into one upstream call.
+// The first caller to claim an ID fetches it, everyone else waits on that call.
+type inFlight struct {
+	mu    sync.Mutex
+	calls map[string]*call
+}
+
+type call struct {
+	done chan struct{}
+	err  error
+}
+
+func newInFlight() *inFlight {
+	return &inFlight{calls: map[string]*call{}}
+}
+
+// claim splits ids into the ones this caller now owns and the calls already
+// fetching the rest
+func (f *inFlight) claim(ids []string) ([]string, *call, []*call) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	var owned []string
+	var waits []*call
+	seen := map[*call]bool{}
+	own := &call{done: make(chan struct{})}
+
+	for _, id := range ids {
+		if c, ok := f.calls[id]; ok {
+			if !seen[c] {
+				seen[c] = true
+				waits = append(waits, c)
+			}
+			continue
+		}
+		f.calls[id] = own
+		owned = append(owned, id)
+	}
+	return owned, own, waits
+}
+
+// finish releases the owned ids and wakes up everyone waiting on them
+func (f *inFlight) finish(ids []string, c *call, err error) {
+	f.mu.Lock()
+	for _, id := range ids {
+		delete(f.calls, id)
+	}
+	f.mu.Unlock()
+
+	c.err = err
+	close(c.done)
+}
+
+// run fetches the owned ids and releases them however fetch ends, a panic
+// included, so waiters never hang on a call that is gone
+func (f *inFlight) run(ids []string, c *call, fetch func() error) (err error) {
+	err = errFetchAborted
+	defer func() {
+		f.finish(ids, c, err)
+	}()
+	return fetch()
+}
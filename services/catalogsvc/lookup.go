@@ -0,0 +1,182 @@
+package catalogsvc
+
+import (
+	"context"
+	"time"
+
+	"github.com/BearTS/go-gin-monolith/database/tables"
+	"github.com/BearTS/go-gin-monolith/spotify"
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+)
+
+// upstream calls are detached from the request that happened to start them,
+// other requests may be waiting on the result
+const upstreamTimeout = 10 * time.Second
+
+func (s *catalogSvcImpl) GetTracks(c *gin.Context, spotifyIDs []string) ([]tables.Tracks, error) {
+	res, err := readThrough(c, s.ttl, spotifyIDs, source[tables.Tracks]{
+		inFlight:  s.tracksInFlight,
+		notFound:  s.tracksNotFound,
+		batchSize: spotify.MaxTracksPerRequest,
+		id:        func(t tables.Tracks) string { return t.SpotifyID },
+		fetchedAt: func(t tables.Tracks) time.Time { return t.FetchedAt },
+		load:      s.tracksGorm.GetTracksBySpotifyIDs,
+		store:     s.tracksGorm.UpsertTracks,
+		fetch: func(ids []string) ([]tables.Tracks, error) {
+			ctx, cancel := context.WithTimeout(context.Background(), upstreamTimeout)
+			defer cancel()
+
+			tracks, err := s.spotify.GetTracks(ctx, ids)
+			if err != nil {
+				return nil, err
+			}
+
+			rows := make([]tables.Tracks, 0, len(tracks))
+			for _, track := range tracks {
+				rows = append(rows, trackRow(track))
+			}
+			return rows, nil
+		},
+	})
+	if err != nil {
+		return res, errors.Wrap(err, "[GetTracks]")
+	}
+	return res, nil
+}
+
+func (s *catalogSvcImpl) GetArtists(c *gin.Context, spotifyIDs []string) ([]tables.Artists, error) {
+	res, err := readThrough(c, s.ttl, spotifyIDs, source[tables.Artists]{
+		inFlight:  s.artistsInFlight,
+		notFound:  s.artistsNotFound,
+		batchSize: spotify.MaxArtistsPerRequest,
+		id:        func(a tables.Artists) string { return a.SpotifyID },
+		fetchedAt: func(a tables.Artists) time.Time { return a.FetchedAt },
+		load:      s.artistsGorm.GetArtistsBySpotifyIDs,
+		store:     s.artistsGorm.UpsertArtists,
+		fetch: func(ids []string) ([]tables.Artists, error) {
+			ctx, cancel := context.WithTimeout(context.Background(), upstreamTimeout)
+			defer cancel()
+
+			artists, err := s.spotify.GetArtists(ctx, ids)
+			if err != nil {
+				return nil, err
+			}
+
+			rows := make([]tables.Artists, 0, len(artists))
+			for _, artist := range artists {
+				rows = append(rows, artistRow(artist))
+			}
+			return rows, nil
+		},
+	})
+	if err != nil {
+		return res, errors.Wrap(err, "[GetArtists]")
+	}
+	return res, nil
+}
+
+func (s *catalogSvcImpl) GetAlbums(c *gin.Context, spotifyIDs []string) ([]tables.Albums, error) {
+	res, err := readThrough(c, s.ttl, spotifyIDs, source[tables.Albums]{
+		inFlight:  s.albumsInFlight,
+		notFound:  s.albumsNotFound,
+		batchSize: spotify.MaxAlbumsPerRequest,
+		id:        func(a tables.Albums) string { return a.SpotifyID },
+		fetchedAt: func(a tables.Albums) time.Time { return a.FetchedAt },
+		load:      s.albumsGorm.GetAlbumsBySpotifyIDs,
+		store:     s.albumsGorm.UpsertAlbums,
+		fetch: func(ids []string) ([]tables.Albums, error) {
+			ctx, cancel := context.WithTimeout(context.Background(), upstreamTimeout)
+			defer cancel()
+
+			albums, err := s.spotify.GetAlbums(ctx, ids)
+			if err != nil {
+				return nil, err
+			}
+
+			rows := make([]tables.Albums, 0, len(albums))
+			for _, album := range albums {
+				rows = append(rows, albumRow(album))
+			}
+			return rows, nil
+		},
+	})
+	if err != nil {
+		return res, errors.Wrap(err, "[GetAlbums]")
+	}
+	return res, nil
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                   Mappers                                  */
+/* -------------------------------------------------------------------------- */
+
+func trackRow(track spotify.Track) tables.Tracks {
+	var row tables.Tracks
+
+	row.SpotifyID = track.ID
+	row.Name = track.Name
+	row.AlbumID = track.Album.ID
+	row.ArtistIDs = artistIDs(track.Artists)
+	row.DurationMs = track.DurationMs
+	row.Popularity = track.Popularity
+	row.Explicit = track.Explicit
+	row.URI = track.URI
+	row.Images = images(track.Album.Images)
+	row.Markets = tables.StringArray(track.AvailableMarkets)
+	row.FetchedAt = time.Now()
+
+	return row
+}
+
+func artistRow(artist spotify.Artist) tables.Artists {
+	var row tables.Artists
+
+	row.SpotifyID = artist.ID
+	row.Name = artist.Name
+	row.Genres = tables.StringArray(artist.Genres)
+	row.Images = images(artist.Images)
+	row.Popularity = artist.Popularity
+	row.URI = artist.URI
+	row.FetchedAt = time.Now()
+
+	return row
+}
+
+func albumRow(album spotify.Album) tables.Albums {
+	var row tables.Albums
+
+	row.SpotifyID = album.ID
+	row.Name = album.Name
+	row.AlbumType = album.AlbumType
+	row.ReleaseDate = album.ReleaseDate
+	row.ArtistIDs = artistIDs(album.Artists)
+	row.Images = images(album.Images)
+	row.Genres = tables.StringArray(album.Genres)
+	row.Markets = tables.StringArray(album.AvailableMarkets)
+	row.Popularity = album.Popularity
+	row.URI = album.URI
+	row.FetchedAt = time.Now()
+
+	return row
+}
+
+func artistIDs(artists []spotify.SimpleArtist) tables.StringArray {
+	ids := tables.StringArray{}
+	for _, artist := range artists {
+		ids = append(ids, artist.ID)
+	}
+	return ids
+}
+
+func images(imgs []spotify.Image) tables.JSONB {
+	res := tables.JSONB{}
+	for _, img := range imgs {
+		res = append(res, map[string]interface{}{
+			"url":    img.URL,
+			"height": img.Height,
+			"width":  img.Width,
+		})
+	}
+	return res
+}
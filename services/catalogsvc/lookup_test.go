@@ -0,0 +1,226 @@
+package catalogsvc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/BearTS/go-gin-monolith/database/tables"
+	"github.com/BearTS/go-gin-monolith/spotify"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+/* -------------------------------------------------------------------------- */
+/*                                    Fakes                                   */
+/* -------------------------------------------------------------------------- */
+
+type fakeTracksGorm struct {
+	mu   sync.Mutex
+	rows map[string]tables.Tracks
+}
+
+func (f *fakeTracksGorm) GetTracksBySpotifyIDs(ctx *gin.Context, spotifyIDs []string) ([]tables.Tracks, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	var res []tables.Tracks
+	for _, id := range spotifyIDs {
+		if row, ok := f.rows[id]; ok {
+			res = append(res, row)
+		}
+	}
+	return res, nil
+}
+
+func (f *fakeTracksGorm) UpsertTracks(ctx *gin.Context, tracks []tables.Tracks) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for _, track := range tracks {
+		f.rows[track.SpotifyID] = track
+	}
+	return nil
+}
+
+type fakeSpotify struct {
+	calls   int32
+	batches [][]string
+	mu      sync.Mutex
+	err     error
+	unknown map[string]bool // IDs Spotify answers without
+	panics  bool
+}
+
+func (f *fakeSpotify) GetTracks(ctx context.Context, ids []string) ([]spotify.Track, error) {
+	atomic.AddInt32(&f.calls, 1)
+	f.mu.Lock()
+	f.batches = append(f.batches, ids)
+	f.mu.Unlock()
+
+	// long enough for concurrent callers to pile up behind this one
+	time.Sleep(20 * time.Millisecond)
+	if f.panics {
+		panic("spotify client blew up")
+	}
+	if f.err != nil {
+		return nil, f.err
+	}
+
+	var res []spotify.Track
+	for _, id := range ids {
+		if f.unknown[id] {
+			continue
+		}
+		res = append(res, spotify.Track{ID: id, Name: "track " + id})
+	}
+	return res, nil
+}
+
+func (f *fakeSpotify) GetArtists(ctx context.Context, ids []string) ([]spotify.Artist, error) {
+	return nil, nil
+}
+
+func (f *fakeSpotify) GetAlbums(ctx context.Context, ids []string) ([]spotify.Album, error) {
+	return nil, nil
+}
+
+func (f *fakeSpotify) Search(ctx context.Context, query string, types []string, market string, limit int, offset int) (spotify.SearchResult, error) {
+	return spotify.SearchResult{}, nil
+}
+
+func testCatalog(store *fakeTracksGorm, upstream *fakeSpotify) *catalogSvcImpl {
+	return &catalogSvcImpl{
+		tracksGorm:     store,
+		spotify:        upstream,
+		ttl:            time.Hour,
+		tracksInFlight: newInFlight(),
+		tracksNotFound: newNotFound(time.Hour),
+	}
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                    Tests                                   */
+/* -------------------------------------------------------------------------- */
+
+func TestGetTracksCollapsesConcurrentMisses(t *testing.T) {
+	store := &fakeTracksGorm{rows: map[string]tables.Tracks{}}
+	upstream := &fakeSpotify{}
+	svc := testCatalog(store, upstream)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			res, err := svc.GetTracks(&gin.Context{}, []string{"new"})
+			assert.NoError(t, err)
+			assert.Len(t, res, 1)
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, int32(1), upstream.calls)
+}
+
+func TestGetTracksBatchesMissesAndKeepsOrder(t *testing.T) {
+	store := &fakeTracksGorm{rows: map[string]tables.Tracks{}}
+	upstream := &fakeSpotify{}
+	svc := testCatalog(store, upstream)
+
+	var ids []string
+	for i := 0; i < 120; i++ {
+		ids = append(ids, fmt.Sprintf("t%03d", i))
+	}
+
+	res, err := svc.GetTracks(&gin.Context{}, append(ids, ids[0]))
+	assert.NoError(t, err)
+	assert.Len(t, res, 120)
+	for i, row := range res {
+		assert.Equal(t, ids[i], row.SpotifyID)
+	}
+
+	assert.Equal(t, int32(3), upstream.calls)
+	assert.Len(t, upstream.batches[0], 50)
+	assert.Len(t, upstream.batches[2], 20)
+}
+
+func TestGetTracksRefreshesOnlyStaleRows(t *testing.T) {
+	store := &fakeTracksGorm{rows: map[string]tables.Tracks{
+		"fresh": {SpotifyID: "fresh", FetchedAt: time.Now()},
+		"stale": {SpotifyID: "stale", FetchedAt: time.Now().Add(-2 * time.Hour)},
+	}}
+	upstream := &fakeSpotify{}
+	svc := testCatalog(store, upstream)
+
+	res, err := svc.GetTracks(&gin.Context{}, []string{"fresh", "stale"})
+	assert.NoError(t, err)
+	assert.Len(t, res, 2)
+	assert.Equal(t, [][]string{{"stale"}}, upstream.batches)
+	assert.Equal(t, "track stale", res[1].Name)
+}
+
+func TestGetTracksServesStaleWhenSpotifyFails(t *testing.T) {
+	store := &fakeTracksGorm{rows: map[string]tables.Tracks{
+		"stale": {SpotifyID: "stale", Name: "old name", FetchedAt: time.Now().Add(-2 * time.Hour)},
+	}}
+	upstream := &fakeSpotify{err: errors.New("spotify down")}
+	svc := testCatalog(store, upstream)
+
+	res, err := svc.GetTracks(&gin.Context{}, []string{"stale"})
+	assert.NoError(t, err)
+	assert.Equal(t, "old name", res[0].Name)
+
+	_, err = svc.GetTracks(&gin.Context{}, []string{"stale", "missing"})
+	assert.Error(t, err)
+}
+
+func TestGetTracksRemembersUnknownIDs(t *testing.T) {
+	store := &fakeTracksGorm{rows: map[string]tables.Tracks{}}
+	upstream := &fakeSpotify{unknown: map[string]bool{"missing": true}}
+	svc := testCatalog(store, upstream)
+
+	for i := 0; i < 3; i++ {
+		res, err := svc.GetTracks(&gin.Context{}, []string{"missing"})
+		assert.NoError(t, err)
+		assert.Empty(t, res)
+	}
+	assert.Equal(t, int32(1), upstream.calls)
+
+	// once the entry expires Spotify is asked again
+	svc.tracksNotFound.ttl = 0
+	svc.tracksNotFound.add([]string{"missing"})
+	_, err := svc.GetTracks(&gin.Context{}, []string{"missing"})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(2), upstream.calls)
+}
+
+func TestGetTracksReleasesWaitersWhenFetchPanics(t *testing.T) {
+	store := &fakeTracksGorm{rows: map[string]tables.Tracks{}}
+	upstream := &fakeSpotify{panics: true}
+	svc := testCatalog(store, upstream)
+
+	go func() {
+		defer func() { _ = recover() }()
+		_, _ = svc.GetTracks(&gin.Context{}, []string{"new"})
+	}()
+	// let the first call claim the ID before the second asks for it
+	time.Sleep(5 * time.Millisecond)
+
+	done := make(chan error)
+	go func() {
+		_, err := svc.GetTracks(&gin.Context{}, []string{"new"})
+		done <- err
+	}()
+
+	select {
+	case err := <-done:
+		assert.Error(t, err)
+	case <-time.After(time.Second):
+		t.Fatal("waiter still blocked after the owning fetch panicked")
+	}
+}
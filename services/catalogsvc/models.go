@@ -0,0 +1,36 @@
+package catalogsvc
+
+import "github.com/BearTS/go-gin-monolith/database/tables"
+
+type TrackRes struct {
+	SpotifyID  string       `json:"spotify_id"`
+	Name       string       `json:"name"`
+	AlbumID    string       `json:"album_id"`
+	ArtistIDs  []string     `json:"artist_ids"`
+	DurationMs int          `json:"duration_ms"`
+	Explicit   bool         `json:"explicit"`
+	Popularity int          `json:"popularity"`
+	Images     tables.JSONB `json:"images"`
+	URI        string       `json:"uri"`
+}
+
+type ArtistRes struct {
+	SpotifyID  string       `json:"spotify_id"`
+	Name       string       `json:"name"`
+	Genres     []string     `json:"genres"`
+	Popularity int          `json:"popularity"`
+	Images     tables.JSONB `json:"images"`
+	URI        string       `json:"uri"`
+}
+
+type AlbumRes struct {
+	SpotifyID   string       `json:"spotify_id"`
+	Name        string       `json:"name"`
+	AlbumType   string       `json:"album_type"`
+	ReleaseDate string       `json:"release_date"`
+	ArtistIDs   []string     `json:"artist_ids"`
+	Genres      []string     `json:"genres"`
+	Popularity  int          `json:"popularity"`
+	Images      tables.JSONB `json:"images"`
+	URI         string       `json:"uri"`
+}
@@ -0,0 +1,49 @@
+package catalogsvc
+
+import (
+	"sync"
+	"time"
+)
+
+// notFoundTTL is how long an ID Spotify didn't know is left alone, short so a
+// newly published track shows up soon
+const notFoundTTL = 5 * time.Minute
+
+// notFound remembers IDs Spotify answered without, so asking for them again
+// doesn't cost an upstream call each time
+type notFound struct {
+	mu      sync.Mutex
+	ttl     time.Duration
+	expires map[string]time.Time
+}
+
+func newNotFound(ttl time.Duration) *notFound {
+	return &notFound{ttl: ttl, expires: map[string]time.Time{}}
+}
+
+func (n *notFound) has(id string) bool {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	exp, ok := n.expires[id]
+	if ok && time.Now().After(exp) {
+		delete(n.expires, id)
+		return false
+	}
+	return ok
+}
+
+func (n *notFound) add(ids []string) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	now := time.Now()
+	for id, exp := range n.expires {
+		if now.After(exp) {
+			delete(n.expires, id)
+		}
+	}
+	for _, id := range ids {
+		n.expires[id] = now.Add(n.ttl)
+	}
+}
@@ -0,0 +1,133 @@
+package catalogsvc
+
+import (
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+)
+
+// source describes one catalog table for readThrough
+type source[T any] struct {
+	inFlight  *inFlight
+	notFound  *notFound
+	batchSize int
+	id        func(T) string
+	fetchedAt func(T) time.Time
+	load      func(c *gin.Context, ids []string) ([]T, error)
+	fetch     func(ids []string) ([]T, error) // upstream, at most batchSize ids
+	store     func(c *gin.Context, rows []T) error
+}
+
+// readThrough serves fresh rows from the database and refreshes missing or
+// stale ones from Spotify in batches. If Spotify fails, stale rows are still
+// served and only IDs with no row at all cause an error.
+func readThrough[T any](c *gin.Context, ttl time.Duration, ids []string, src source[T]) ([]T, error) {
+	ids = dedupe(ids)
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	rows, err := src.load(c, ids)
+	if err != nil {
+		return nil, errors.Wrap(err, "[readThrough][load]")
+	}
+
+	cutoff := time.Now().Add(-ttl)
+	byID := map[string]T{}
+	for _, row := range rows {
+		byID[src.id(row)] = row
+	}
+
+	var need []string
+	for _, id := range ids {
+		row, ok := byID[id]
+		if (!ok || src.fetchedAt(row).Before(cutoff)) && !src.notFound.has(id) {
+			need = append(need, id)
+		}
+	}
+
+	if len(need) > 0 {
+		owned, own, waits := src.inFlight.claim(need)
+
+		var fetchErr error
+		if len(owned) > 0 {
+			fetchErr = src.inFlight.run(owned, own, func() error {
+				return refresh(c, owned, src)
+			})
+		}
+		for _, w := range waits {
+			<-w.done
+			if w.err != nil && fetchErr == nil {
+				fetchErr = w.err
+			}
+		}
+
+		rows, err = src.load(c, need)
+		if err != nil {
+			return nil, errors.Wrap(err, "[readThrough][reload]")
+		}
+		for _, row := range rows {
+			byID[src.id(row)] = row
+		}
+
+		if fetchErr != nil {
+			for _, id := range need {
+				if _, ok := byID[id]; !ok {
+					return nil, errors.Wrap(fetchErr, "[readThrough][refresh]")
+				}
+			}
+		}
+	}
+
+	res := make([]T, 0, len(ids))
+	for _, id := range ids {
+		if row, ok := byID[id]; ok {
+			res = append(res, row)
+		}
+	}
+	return res, nil
+}
+
+func refresh[T any](c *gin.Context, ids []string, src source[T]) error {
+	for start := 0; start < len(ids); start += src.batchSize {
+		end := start + src.batchSize
+		if end > len(ids) {
+			end = len(ids)
+		}
+
+		rows, err := src.fetch(ids[start:end])
+		if err != nil {
+			return err
+		}
+		if err := src.store(c, rows); err != nil {
+			return err
+		}
+
+		returned := map[string]bool{}
+		for _, row := range rows {
+			returned[src.id(row)] = true
+		}
+		var unknown []string
+		for _, id := range ids[start:end] {
+			if !returned[id] {
+				unknown = append(unknown, id)
+			}
+		}
+		src.notFound.add(unknown)
+	}
+	return nil
+}
+
+func dedupe(ids []string) []string {
+	seen := map[string]bool{}
+	res := make([]string, 0, len(ids))
+	for _, id := range ids {
+		if id == "" || seen[id] {
+			continue
+		}
+		seen[id] = true
+		res = append(res, id)
+	}
+	return res
+}
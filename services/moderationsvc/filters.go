@@ -0,0 +1,112 @@
+package moderationsvc
+
+import "strings"
+
+// Filter is a single stage of the moderation pipeline. It is handed one
+// normalized word at a time and reports whether it objects to it.
+type Filter interface {
+	Match(word string) bool
+}
+
+/* -------------------------------------------------------------------------- */
+/*                              Word List Filter                              */
+/* -------------------------------------------------------------------------- */
+
+type wordListFilter struct {
+	// keyed by the collapsed form, so "fuuuck" finds "fuck"
+	words map[string][][]letterRun
+}
+
+// NewWordListFilter builds a filter from plain words, normalized the same way
+// user text is
+func NewWordListFilter(words []string) Filter {
+	f := &wordListFilter{words: map[string][][]letterRun{}}
+	for _, w := range words {
+		w = normalize(strings.TrimSpace(w))
+		if w == "" {
+			continue
+		}
+		runs := toRuns(w)
+		key := collapse(runs)
+		f.words[key] = append(f.words[key], runs)
+	}
+	return f
+}
+
+// Match allows extra repeats of a letter but never fewer than the listed word
+// has, so "asss" matches "ass" while "as" does not
+func (f *wordListFilter) Match(word string) bool {
+	runs := toRuns(word)
+	for _, listed := range f.words[collapse(runs)] {
+		if len(listed) != len(runs) {
+			continue
+		}
+		ok := true
+		for i := range listed {
+			if runs[i].count < listed[i].count {
+				ok = false
+				break
+			}
+		}
+		if ok {
+			return true
+		}
+	}
+	return false
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                  Pipeline                                  */
+/* -------------------------------------------------------------------------- */
+
+type match struct {
+	start int
+	end   int
+	word  string
+}
+
+// scan runs every word of text through the filters
+func scan(text string, filters []Filter) []match {
+	var matches []match
+	for _, t := range tokenize(text) {
+		if hits(t, filters) {
+			matches = append(matches, match{start: t.start, end: t.end, word: t.text})
+		}
+	}
+	return matches
+}
+
+func hits(t token, filters []Filter) bool {
+	for _, c := range candidates(t) {
+		if c == "" {
+			continue
+		}
+		for _, f := range filters {
+			if f.Match(c) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// mask replaces every rune of a matched word with *
+func mask(text string, matches []match) string {
+	if len(matches) == 0 {
+		return text
+	}
+	var b strings.Builder
+	last := 0
+	for _, m := range matches {
+		b.WriteString(text[last:m.start])
+		for _, r := range text[m.start:m.end] {
+			if isInvisible(r) {
+				continue
+			}
+			b.WriteRune('*')
+		}
+		last = m.end
+	}
+	b.WriteString(text[last:])
+	return b.String()
+}
@@ -0,0 +1,46 @@
+package moderationsvc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var testFilters = []Filter{NewWordListFilter([]string{"darn", "heck", "ass", "hell", "loser", "shit"})}
+
+func TestNormalize(t *testing.T) {
+	assert.Equal(t, "darn", normalize("DaRn"))
+	assert.Equal(t, "heck", normalize("h3ck"))
+	assert.Equal(t, "darn", normalize("d@rn"))
+	assert.Equal(t, "heck", normalize("h\u0435ck"))                // cyrillic e
+	assert.Equal(t, "darn", normalize("\uff44\uff41\uff52\uff4e")) // fullwidth
+	assert.Equal(t, "darn", normalize("d\u200barn"))               // zero width space
+	assert.Equal(t, "heck", normalize("he\u0301ck"))               // combining accent
+}
+
+func TestScanMatches(t *testing.T) {
+	cases := map[string]bool{
+		"well darn it":    true,
+		"d4rn":            true,
+		"daaaarnnn":       true,
+		"what the h3ck!":  true,
+		"$ass":            true,
+		"he11":            true,
+		"1oser":           true,
+		"sh1t":            true,
+		"he11o there":     false,
+		"as far as I can": false,
+		"darning socks":   false,
+		"nothing to see":  false,
+		"":                false,
+	}
+	for text, want := range cases {
+		assert.Equal(t, want, len(scan(text, testFilters)) > 0, text)
+	}
+}
+
+func TestMask(t *testing.T) {
+	text := "oh d4rn, that h3ck!"
+	assert.Equal(t, "oh ****, that *****", mask(text, scan(text, testFilters)))
+	assert.Equal(t, "all clean", mask("all clean", scan("all clean", testFilters)))
+}
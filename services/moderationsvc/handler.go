@@ -0,0 +1,36 @@
+package moderationsvc
+
+import (
+	"strings"
+
+	"github.com/BearTS/go-gin-monolith/config"
+	"github.com/BearTS/go-gin-monolith/database/tables"
+	"github.com/BearTS/go-gin-monolith/dbops/gorm/audit_logs"
+	"github.com/BearTS/go-gin-monolith/dbops/gorm/moderation_flags"
+	"github.com/BearTS/go-gin-monolith/utils"
+	"github.com/gin-gonic/gin"
+)
+
+type moderationSvcImpl struct {
+	moderationFlagsGorm moderation_flags.GormInterface
+	auditLogsGorm       audit_logs.GormInterface
+	filters             []Filter
+}
+
+// interface
+type Interface interface {
+	Moderate(c *gin.Context, req ModerateReq) (utils.BaseResponse, ModerateRes, error)
+	ListFlags(c *gin.Context, req ListFlagsReq) (utils.BaseResponse, []tables.ModerationFlags, error)
+	ReviewFlag(c *gin.Context, req ReviewFlagReq) (utils.BaseResponse, tables.ModerationFlags, error)
+}
+
+// Handler starts the pipeline with the configured word list, extra filters run after it
+func Handler(moderationFlagsGorm moderation_flags.GormInterface, auditLogsGorm audit_logs.GormInterface, filters ...Filter) Interface {
+	wordList := NewWordListFilter(strings.Split(config.Moderation.WordList, ","))
+
+	return &moderationSvcImpl{
+		moderationFlagsGorm: moderationFlagsGorm,
+		auditLogsGorm:       auditLogsGorm,
+		filters:             append([]Filter{wordList}, filters...),
+	}
+}
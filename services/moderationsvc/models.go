@@ -0,0 +1,39 @@
+package moderationsvc
+
+import "time"
+
+type ModerateReq struct {
+	Text      string
+	Action    string // one of constants.ModerationActions
+	Source    string // what the text is, e.g. display_name
+	SourcePID string // pid of the thing the text belongs to, if any
+}
+
+type ModerateRes struct {
+	Text    string   `json:"text"` // masked when the action is mask
+	Matches []string `json:"matches,omitempty"`
+	FlagPID string   `json:"flag_pid,omitempty"`
+}
+
+type ListFlagsReq struct {
+	Status string `form:"status"`
+}
+
+type ReviewFlagReq struct {
+	FlagPID string `json:"-"`
+	Status  string `json:"status" binding:"required"`
+	Notes   string `json:"notes"`
+}
+
+type ModerationFlagRes struct {
+	FlagPID     string        `json:"flag_pid"`
+	Source      string        `json:"source"`
+	SourcePID   string        `json:"source_pid,omitempty"`
+	AuthorPID   string        `json:"author_pid,omitempty"`
+	Text        string        `json:"text"`
+	Matches     []interface{} `json:"matches"`
+	Status      string        `json:"status"`
+	ReviewedBy  string        `json:"reviewed_by,omitempty"`
+	ReviewNotes string        `json:"review_notes,omitempty"`
+	CreatedAt   time.Time     `json:"created_at"`
+}
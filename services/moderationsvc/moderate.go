@@ -0,0 +1,78 @@
+package moderationsvc
+
+import (
+	"net/http"
+
+	"github.com/BearTS/go-gin-monolith/constants"
+	"github.com/BearTS/go-gin-monolith/database/tables"
+	"github.com/BearTS/go-gin-monolith/utils"
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+)
+
+// Moderate checks user supplied text and applies the action the call site asked for.
+// A reject comes back as a 422 base response the caller can hand to merrors.
+func (s *moderationSvcImpl) Moderate(c *gin.Context, req ModerateReq) (utils.BaseResponse, ModerateRes, error) {
+	var baseRes utils.BaseResponse
+	var res ModerateRes
+	var err error
+
+	res.Text = req.Text
+
+	matches := scan(req.Text, s.filters)
+	if len(matches) == 0 {
+		baseRes.Success = true
+		baseRes.StatusCode = http.StatusOK
+		return baseRes, res, err
+	}
+
+	for _, m := range matches {
+		res.Matches = append(res.Matches, m.word)
+	}
+
+	switch req.Action {
+	case constants.ModerationActions.REJECT:
+		baseRes.Success = false
+		baseRes.StatusCode = http.StatusUnprocessableEntity
+		baseRes.Message = req.Source + " contains words that are not allowed"
+		return baseRes, res, err
+
+	case constants.ModerationActions.MASK:
+		res.Text = mask(req.Text, matches)
+
+	case constants.ModerationActions.FLAG:
+		var flag tables.ModerationFlags
+		flag.Source = req.Source
+		flag.SourcePID = req.SourcePID
+		flag.Text = req.Text
+		flag.Status = constants.ModerationFlagStatuses.PENDING
+		for _, m := range res.Matches {
+			flag.Matches = append(flag.Matches, m)
+		}
+
+		authData, _ := utils.GetAuthData(c)
+		if authData != nil {
+			flag.IsSandbox = authData.Sandbox
+			flag.AuthorPID = authData.UserPID
+			if flag.AuthorPID == "" {
+				flag.AuthorPID = authData.GuestPID
+			}
+		}
+
+		flag, err = s.moderationFlagsGorm.CreateFlag(c, flag)
+		if err != nil {
+			return baseRes, res, errors.Wrap(err, "[Moderate][CreateFlag]")
+		}
+		res.FlagPID = flag.PID
+
+	default:
+		baseRes.Success = false
+		baseRes.StatusCode = http.StatusUnprocessableEntity
+		baseRes.Message = "invalid moderation action"
+		return baseRes, res, err
+	}
+
+	baseRes.Success = true
+	baseRes.StatusCode = http.StatusOK
+	return baseRes, res, err
+}
@@ -0,0 +1,178 @@
+package moderationsvc
+
+import (
+	"strings"
+	"unicode"
+)
+
+/* -------------------------------------------------------------------------- */
+/*                                Normalization                               */
+/* -------------------------------------------------------------------------- */
+
+// lookalikes from other scripts and accented latin, folded to plain ascii
+var confusables = map[rune]rune{
+	// cyrillic
+	'а': 'a', 'в': 'b', 'е': 'e', 'ё': 'e', 'к': 'k', 'м': 'm', 'н': 'h', 'о': 'o',
+	'р': 'p', 'с': 'c', 'т': 't', 'у': 'y', 'х': 'x', 'ѕ': 's', 'і': 'i', 'ї': 'i', 'ј': 'j',
+	// greek
+	'α': 'a', 'β': 'b', 'ε': 'e', 'η': 'n', 'ι': 'i', 'κ': 'k', 'ν': 'v', 'ο': 'o',
+	'ρ': 'p', 'τ': 't', 'υ': 'u', 'χ': 'x',
+	// accented latin
+	'à': 'a', 'á': 'a', 'â': 'a', 'ã': 'a', 'ä': 'a', 'å': 'a',
+	'ç': 'c',
+	'è': 'e', 'é': 'e', 'ê': 'e', 'ë': 'e',
+	'ì': 'i', 'í': 'i', 'î': 'i', 'ï': 'i',
+	'ñ': 'n',
+	'ò': 'o', 'ó': 'o', 'ô': 'o', 'õ': 'o', 'ö': 'o', 'ø': 'o',
+	'ù': 'u', 'ú': 'u', 'û': 'u', 'ü': 'u',
+	'ý': 'y', 'ÿ': 'y',
+}
+
+var leetspeak = map[rune]rune{
+	'0': 'o', '1': 'i', '3': 'e', '4': 'a', '5': 's', '7': 't', '8': 'b', '9': 'g',
+	'@': 'a', '$': 's', '!': 'i', '|': 'l',
+}
+
+// leetspeak that reads as a second letter too, "1oser" is l where "sh1t" is i
+var altLeetspeak = map[rune]rune{
+	'1': 'l',
+}
+
+// invisible characters people slip between letters to dodge filters
+func isInvisible(r rune) bool {
+	switch r {
+	case '\u200b', '\u200c', '\u200d', '\u2060', '\ufeff', '\u00ad':
+		return true
+	}
+	return false
+}
+
+// foldRune maps a single rune to its comparable form, 0 if it should be dropped
+func foldRune(r rune) rune {
+	// fullwidth ascii to ascii
+	if r >= '\uff01' && r <= '\uff5e' {
+		r -= 0xfee0
+	}
+	r = unicode.ToLower(r)
+	if c, ok := confusables[r]; ok {
+		return c
+	}
+	if l, ok := leetspeak[r]; ok {
+		return l
+	}
+	if unicode.IsLetter(r) || unicode.IsDigit(r) {
+		return r
+	}
+	return 0
+}
+
+// isWordRune reports whether r can be part of a word, leetspeak symbols included
+func isWordRune(r rune) bool {
+	if r >= '\uff01' && r <= '\uff5e' {
+		r -= 0xfee0
+	}
+	if _, ok := leetspeak[r]; ok {
+		return true
+	}
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || unicode.Is(unicode.Mn, r) || isInvisible(r)
+}
+
+// normalize folds case, confusables and leetspeak and drops everything else
+func normalize(word string) string {
+	var b strings.Builder
+	for _, r := range word {
+		if f := foldRune(r); f != 0 {
+			b.WriteRune(f)
+		}
+	}
+	return b.String()
+}
+
+/* -------------------------------------------------------------------------- */
+/*                              Repeated letters                              */
+/* -------------------------------------------------------------------------- */
+
+type letterRun struct {
+	letter rune
+	count  int
+}
+
+func toRuns(word string) []letterRun {
+	var runs []letterRun
+	for _, r := range word {
+		if n := len(runs); n > 0 && runs[n-1].letter == r {
+			runs[n-1].count++
+			continue
+		}
+		runs = append(runs, letterRun{letter: r, count: 1})
+	}
+	return runs
+}
+
+// collapse squeezes repeated letters, "fuuuun" and "fun" both become "fun"
+func collapse(runs []letterRun) string {
+	var b strings.Builder
+	for _, run := range runs {
+		b.WriteRune(run.letter)
+	}
+	return b.String()
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                Tokenization                                */
+/* -------------------------------------------------------------------------- */
+
+type token struct {
+	start int // byte offsets into the original text
+	end   int
+	text  string
+}
+
+func tokenize(text string) []token {
+	var tokens []token
+	start := -1
+	for i, r := range text {
+		if isWordRune(r) {
+			if start < 0 {
+				start = i
+			}
+			continue
+		}
+		if start >= 0 {
+			tokens = append(tokens, token{start: start, end: i, text: text[start:i]})
+			start = -1
+		}
+	}
+	if start >= 0 {
+		tokens = append(tokens, token{start: start, end: len(text), text: text[start:]})
+	}
+	return tokens
+}
+
+// readings normalizes word once per way its leetspeak can be read
+func readings(word string) []string {
+	res := []string{normalize(word)}
+	alt := strings.Map(func(r rune) rune {
+		if a, ok := altLeetspeak[r]; ok {
+			return a
+		}
+		return r
+	}, word)
+	if alt != word {
+		res = append(res, normalize(alt))
+	}
+	return res
+}
+
+// candidates returns the normalized forms of a token worth checking. Leading and
+// trailing symbols are tried both ways so "$hit" and "word!" are each read right.
+func candidates(t token) []string {
+	res := readings(t.text)
+	trimmed := strings.TrimFunc(t.text, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	if trimmed != t.text && trimmed != "" {
+		res = append(res, readings(trimmed)...)
+	}
+	return res
+}
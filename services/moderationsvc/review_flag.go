@@ -0,0 +1,104 @@
+package moderationsvc
+
+import (
+	"net/http"
+
+	"github.com/BearTS/go-gin-monolith/constants"
+	"github.com/BearTS/go-gin-monolith/database/tables"
+	"github.com/BearTS/go-gin-monolith/dbops/gorm/moderation_flags"
+	"github.com/BearTS/go-gin-monolith/utils"
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+func (s *moderationSvcImpl) ListFlags(c *gin.Context, req ListFlagsReq) (utils.BaseResponse, []tables.ModerationFlags, error) {
+	var baseRes utils.BaseResponse
+	var res []tables.ModerationFlags
+	var err error
+
+	if req.Status == "" {
+		req.Status = constants.ModerationFlagStatuses.PENDING
+	}
+
+	res, err = s.moderationFlagsGorm.GetFlagsByStatus(c, req.Status)
+	if err != nil {
+		baseRes.Message = "Internal Server Error"
+		return baseRes, res, errors.Wrap(err, "[ListFlags][GetFlagsByStatus]")
+	}
+
+	baseRes.Success = true
+	baseRes.StatusCode = http.StatusOK
+	baseRes.Message = "moderation flags fetched successfully"
+
+	return baseRes, res, err
+}
+
+func (s *moderationSvcImpl) ReviewFlag(c *gin.Context, req ReviewFlagReq) (utils.BaseResponse, tables.ModerationFlags, error) {
+	var baseRes utils.BaseResponse
+	var res tables.ModerationFlags
+	var err error
+
+	// Add initial Response
+	baseRes.Success = false
+	baseRes.StatusCode = http.StatusInternalServerError
+	baseRes.Message = "Internal Server Error"
+
+	if req.Status != constants.ModerationFlagStatuses.APPROVED && req.Status != constants.ModerationFlagStatuses.REMOVED {
+		baseRes.StatusCode = http.StatusUnprocessableEntity
+		baseRes.Message = "status must be approved or removed"
+		return baseRes, res, err
+	}
+
+	res, err = s.moderationFlagsGorm.GetFlagByPID(c, req.FlagPID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		baseRes.StatusCode = http.StatusNotFound
+		baseRes.Message = "moderation flag not found"
+		return baseRes, res, nil
+	}
+	if err != nil {
+		return baseRes, res, errors.Wrap(err, "[ReviewFlag][GetFlagByPID]")
+	}
+
+	if res.Status != constants.ModerationFlagStatuses.PENDING {
+		baseRes.StatusCode = http.StatusConflict
+		baseRes.Message = "flag has already been reviewed"
+		return baseRes, res, err
+	}
+
+	authData, err := utils.GetAuthData(c)
+	if err != nil {
+		return baseRes, res, errors.Wrap(err, "[ReviewFlag][GetAuthData]")
+	}
+
+	res.Status = req.Status
+	res.ReviewNotes = req.Notes
+	res.ReviewedBy = authData.AdminPID
+
+	res, err = s.moderationFlagsGorm.UpdateFlag(c, res, constants.ModerationFlagStatuses.PENDING)
+	if errors.Is(err, moderation_flags.ErrFlagAlreadyReviewed) {
+		baseRes.StatusCode = http.StatusConflict
+		baseRes.Message = "flag has already been reviewed"
+		return baseRes, res, nil
+	}
+	if err != nil {
+		return baseRes, res, errors.Wrap(err, "[ReviewFlag][UpdateFlag]")
+	}
+
+	var auditLog tables.AuditLogs
+	auditLog.Action = constants.AuditActions.MODERATION_FLAG_REVIEWED
+	auditLog.EntityType = constants.AuditEntityTypes.MODERATION_FLAG
+	auditLog.EntityPID = res.PID
+	auditLog.Notes = req.Status + ": " + req.Notes
+
+	_, err = s.auditLogsGorm.CreateAuditLog(c, auditLog)
+	if err != nil {
+		return baseRes, res, errors.Wrap(err, "[ReviewFlag][CreateAuditLog]")
+	}
+
+	baseRes.Success = true
+	baseRes.StatusCode = http.StatusOK
+	baseRes.Message = "moderation flag reviewed successfully"
+
+	return baseRes, res, err
+}
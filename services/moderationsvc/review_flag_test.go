@@ -0,0 +1,106 @@
+package moderationsvc
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/BearTS/go-gin-monolith/config"
+	"github.com/BearTS/go-gin-monolith/constants"
+	"github.com/BearTS/go-gin-monolith/database/tables"
+	"github.com/BearTS/go-gin-monolith/dbops/gorm/audit_logs"
+	"github.com/BearTS/go-gin-monolith/dbops/gorm/moderation_flags"
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+type fakeFlagsGorm struct {
+	moderation_flags.GormInterface
+	flags map[string]tables.ModerationFlags
+	// reviewedMeanwhile makes UpdateFlag lose the race with another reviewer
+	reviewedMeanwhile bool
+}
+
+func (f *fakeFlagsGorm) GetFlagByPID(ctx *gin.Context, pid string) (tables.ModerationFlags, error) {
+	flag, ok := f.flags[pid]
+	if !ok {
+		return flag, gorm.ErrRecordNotFound
+	}
+	return flag, nil
+}
+
+func (f *fakeFlagsGorm) UpdateFlag(ctx *gin.Context, flag tables.ModerationFlags, fromStatus string) (tables.ModerationFlags, error) {
+	if f.reviewedMeanwhile || f.flags[flag.PID].Status != fromStatus {
+		return flag, moderation_flags.ErrFlagAlreadyReviewed
+	}
+	f.flags[flag.PID] = flag
+	return flag, nil
+}
+
+type fakeAuditLogsGorm struct {
+	audit_logs.GormInterface
+	logs []tables.AuditLogs
+}
+
+func (f *fakeAuditLogsGorm) CreateAuditLog(ctx *gin.Context, auditLog tables.AuditLogs) (tables.AuditLogs, error) {
+	f.logs = append(f.logs, auditLog)
+	return auditLog, nil
+}
+
+func newReviewTest(t *testing.T) (*fakeFlagsGorm, *fakeAuditLogsGorm, Interface, *gin.Context) {
+	config.Token = &config.TokenConfig{AccessSecret: "test-access"}
+	config.Moderation = &config.ModerationConfig{}
+
+	flagsGorm := &fakeFlagsGorm{flags: map[string]tables.ModerationFlags{
+		"flg_1": {PID: "flg_1", Status: constants.ModerationFlagStatuses.PENDING},
+	}}
+	auditLogsGorm := &fakeAuditLogsGorm{}
+
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"type":      constants.TokenTypes.ADMIN,
+		"admin_pid": "adm_1",
+		"exp":       time.Now().Add(time.Minute).Unix(),
+	}).SignedString([]byte(config.Token.AccessSecret))
+	require.NoError(t, err)
+
+	ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+	ctx.Request = httptest.NewRequest(http.MethodPatch, "/", nil)
+	ctx.Request.Header.Set("Authorization", token)
+
+	return flagsGorm, auditLogsGorm, Handler(flagsGorm, auditLogsGorm), ctx
+}
+
+func TestReviewFlag(t *testing.T) {
+	flagsGorm, auditLogsGorm, svc, ctx := newReviewTest(t)
+
+	baseRes, res, err := svc.ReviewFlag(ctx, ReviewFlagReq{FlagPID: "flg_1", Status: constants.ModerationFlagStatuses.REMOVED})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, baseRes.StatusCode, baseRes.Message)
+
+	assert.Equal(t, "adm_1", res.ReviewedBy)
+	assert.Equal(t, constants.ModerationFlagStatuses.REMOVED, flagsGorm.flags["flg_1"].Status)
+	assert.Len(t, auditLogsGorm.logs, 1)
+}
+
+func TestReviewFlagNotFound(t *testing.T) {
+	_, auditLogsGorm, svc, ctx := newReviewTest(t)
+
+	baseRes, _, err := svc.ReviewFlag(ctx, ReviewFlagReq{FlagPID: "flg_unknown", Status: constants.ModerationFlagStatuses.APPROVED})
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, baseRes.StatusCode)
+	assert.Empty(t, auditLogsGorm.logs)
+}
+
+func TestReviewFlagAlreadyReviewed(t *testing.T) {
+	flagsGorm, auditLogsGorm, svc, ctx := newReviewTest(t)
+	flagsGorm.reviewedMeanwhile = true
+
+	baseRes, _, err := svc.ReviewFlag(ctx, ReviewFlagReq{FlagPID: "flg_1", Status: constants.ModerationFlagStatuses.APPROVED})
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusConflict, baseRes.StatusCode)
+	assert.Empty(t, auditLogsGorm.logs)
+}
@@ -0,0 +1,104 @@
+package reportsvc
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/BearTS/go-gin-monolith/constants"
+	"github.com/BearTS/go-gin-monolith/database/tables"
+	"github.com/BearTS/go-gin-monolith/utils"
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+func (s *reportSvcImpl) CreateReport(c *gin.Context, req CreateReportReq) (utils.BaseResponse, tables.Reports, error) {
+	var baseRes utils.BaseResponse
+	var res tables.Reports
+	var err error
+
+	// Add initial Response
+	baseRes.Success = false
+	baseRes.StatusCode = http.StatusInternalServerError
+	baseRes.Message = "Internal Server Error"
+
+	authData, err := utils.GetAuthData(c)
+	if err != nil {
+		return baseRes, res, err
+	}
+
+	// users and room guests can report, admins use the review queue instead
+	reporterPID := authData.UserPID
+	if authData.Type == constants.TokenTypes.GUEST {
+		reporterPID = authData.GuestPID
+	}
+	if reporterPID == "" {
+		baseRes.StatusCode = http.StatusForbidden
+		baseRes.Message = "only room members can file reports"
+		return baseRes, res, err
+	}
+
+	switch req.TargetType {
+	case constants.ReportTargetTypes.MESSAGE, constants.ReportTargetTypes.ROOM:
+	case constants.ReportTargetTypes.MEMBER:
+		if req.TargetPID == reporterPID {
+			baseRes.StatusCode = http.StatusUnprocessableEntity
+			baseRes.Message = "you cannot report yourself"
+			return baseRes, res, err
+		}
+
+		found, err := s.memberExists(c, req.TargetPID)
+		if err != nil {
+			return baseRes, res, err
+		}
+		if !found {
+			baseRes.StatusCode = http.StatusUnprocessableEntity
+			baseRes.Message = "reported member not found"
+			return baseRes, res, err
+		}
+	default:
+		baseRes.StatusCode = http.StatusUnprocessableEntity
+		baseRes.Message = "invalid report target type"
+		return baseRes, res, err
+	}
+
+	var report tables.Reports
+	report.ReporterPID = reporterPID
+	report.TargetType = req.TargetType
+	report.TargetPID = req.TargetPID
+	report.Reason = req.Reason
+	report.Details = req.Details
+	report.Status = constants.ReportStatuses.OPEN
+	report.IsSandbox = authData.Sandbox
+
+	res, err = s.reportsGorm.CreateReport(c, report)
+	if err != nil {
+		return baseRes, res, err
+	}
+
+	// Add Success Response
+	baseRes.Success = true
+	baseRes.StatusCode = http.StatusOK
+	baseRes.Message = "report filed successfully"
+
+	return baseRes, res, err
+}
+
+// memberExists looks the pid up as a user first, then as a room guest
+func (s *reportSvcImpl) memberExists(c *gin.Context, pid string) (bool, error) {
+	_, err := s.usersGorm.GetUserDetailsByPID(c, pid)
+	if err == nil {
+		return true, nil
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return false, err
+	}
+
+	_, err = s.guestsGorm.GetGuestByPID(c, pid)
+	if err == nil {
+		return true, nil
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return false, err
+	}
+	return false, nil
+}
@@ -0,0 +1,37 @@
+package reportsvc
+
+import (
+	"github.com/BearTS/go-gin-monolith/database/tables"
+	"github.com/BearTS/go-gin-monolith/dbops/gorm/audit_logs"
+	"github.com/BearTS/go-gin-monolith/dbops/gorm/guests"
+	"github.com/BearTS/go-gin-monolith/dbops/gorm/reports"
+	"github.com/BearTS/go-gin-monolith/dbops/gorm/users"
+	"github.com/BearTS/go-gin-monolith/utils"
+	"github.com/gin-gonic/gin"
+)
+
+type reportSvcImpl struct {
+	reportsGorm   reports.GormInterface
+	auditLogsGorm audit_logs.GormInterface
+	usersGorm     users.GormInterface
+	guestsGorm    guests.GormInterface
+}
+
+// interface
+type Interface interface {
+	CreateReport(c *gin.Context, req CreateReportReq) (utils.BaseResponse, tables.Reports, error)
+	ListReports(c *gin.Context, req ListReportsReq) (utils.BaseResponse, []tables.Reports, error)
+	GetReport(c *gin.Context, reportPID string) (utils.BaseResponse, ReportDetails, error)
+	ClaimReport(c *gin.Context, req ReportActionReq) (utils.BaseResponse, tables.Reports, error)
+	ResolveReport(c *gin.Context, req ReportActionReq) (utils.BaseResponse, tables.Reports, error)
+	DismissReport(c *gin.Context, req ReportActionReq) (utils.BaseResponse, tables.Reports, error)
+}
+
+func Handler(reportsGorm reports.GormInterface, auditLogsGorm audit_logs.GormInterface, usersGorm users.GormInterface, guestsGorm guests.GormInterface) Interface {
+	return &reportSvcImpl{
+		reportsGorm:   reportsGorm,
+		auditLogsGorm: auditLogsGorm,
+		usersGorm:     usersGorm,
+		guestsGorm:    guestsGorm,
+	}
+}
@@ -0,0 +1,65 @@
+package reportsvc
+
+import (
+	"time"
+
+	"github.com/BearTS/go-gin-monolith/database/tables"
+)
+
+type CreateReportReq struct {
+	TargetType string `json:"target_type" binding:"required"`
+	TargetPID  string `json:"target_pid" binding:"required"`
+	Reason     string `json:"reason" binding:"required,max=100"`
+	Details    string `json:"details"`
+}
+
+type CreateReportRes struct {
+	ReportPID string `json:"report_pid"`
+	Status    string `json:"status"`
+}
+
+type ListReportsReq struct {
+	Status string `form:"status"`
+}
+
+// ReportActionReq is shared by claim, resolve and dismiss
+type ReportActionReq struct {
+	ReportPID string `json:"-"`
+	Action    string `json:"action"` // resolve only, one of constants.ReportActions
+	Notes     string `json:"notes"`
+}
+
+type ReportDetails struct {
+	Report     tables.Reports
+	AuditTrail []tables.AuditLogs
+}
+
+type ReportRes struct {
+	ReportPID        string     `json:"report_pid"`
+	ReporterPID      string     `json:"reporter_pid"`
+	TargetType       string     `json:"target_type"`
+	TargetPID        string     `json:"target_pid"`
+	Reason           string     `json:"reason"`
+	Details          string     `json:"details,omitempty"`
+	Status           string     `json:"status"`
+	ClaimedBy        string     `json:"claimed_by,omitempty"`
+	ResolutionAction string     `json:"resolution_action,omitempty"`
+	ResolutionNotes  string     `json:"resolution_notes,omitempty"`
+	ClosedAt         *time.Time `json:"closed_at,omitempty"`
+	CreatedAt        time.Time  `json:"created_at"`
+}
+
+type AuditLogRes struct {
+	AuditPID   string    `json:"audit_pid"`
+	ActorPID   string    `json:"actor_pid"`
+	Action     string    `json:"action"`
+	EntityType string    `json:"entity_type"`
+	EntityPID  string    `json:"entity_pid"`
+	Notes      string    `json:"notes,omitempty"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+type ReportDetailsRes struct {
+	Report     ReportRes     `json:"report"`
+	AuditTrail []AuditLogRes `json:"audit_trail"`
+}
@@ -0,0 +1,296 @@
+package reportsvc
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/BearTS/go-gin-monolith/constants"
+	"github.com/BearTS/go-gin-monolith/database/tables"
+	"github.com/BearTS/go-gin-monolith/dbops/gorm/reports"
+	"github.com/BearTS/go-gin-monolith/utils"
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+func (s *reportSvcImpl) ListReports(c *gin.Context, req ListReportsReq) (utils.BaseResponse, []tables.Reports, error) {
+	var baseRes utils.BaseResponse
+	var res []tables.Reports
+	var err error
+
+	if req.Status == "" {
+		req.Status = constants.ReportStatuses.OPEN
+	}
+
+	res, err = s.reportsGorm.GetReportsByStatus(c, req.Status)
+	if err != nil {
+		baseRes.Message = "Internal Server Error"
+		return baseRes, res, errors.Wrap(err, "[ListReports][GetReportsByStatus]")
+	}
+
+	baseRes.Success = true
+	baseRes.StatusCode = http.StatusOK
+	baseRes.Message = "reports fetched successfully"
+
+	return baseRes, res, err
+}
+
+func (s *reportSvcImpl) GetReport(c *gin.Context, reportPID string) (utils.BaseResponse, ReportDetails, error) {
+	var baseRes utils.BaseResponse
+	var res ReportDetails
+	var err error
+
+	baseRes.Message = "Internal Server Error"
+
+	res.Report, err = s.reportsGorm.GetReportByPID(c, reportPID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		baseRes.StatusCode = http.StatusNotFound
+		baseRes.Message = "report not found"
+		return baseRes, res, nil
+	}
+	if err != nil {
+		return baseRes, res, errors.Wrap(err, "[GetReport][GetReportByPID]")
+	}
+
+	res.AuditTrail, err = s.auditLogsGorm.GetAuditLogsByEntityPID(c, reportPID)
+	if err != nil {
+		return baseRes, res, errors.Wrap(err, "[GetReport][GetAuditLogsByEntityPID]")
+	}
+
+	baseRes.Success = true
+	baseRes.StatusCode = http.StatusOK
+	baseRes.Message = "report fetched successfully"
+
+	return baseRes, res, err
+}
+
+func (s *reportSvcImpl) ClaimReport(c *gin.Context, req ReportActionReq) (utils.BaseResponse, tables.Reports, error) {
+	baseRes, res, adminPID, err := s.getReportForAdmin(c, req.ReportPID)
+	if err != nil || baseRes.StatusCode != http.StatusOK {
+		return baseRes, res, err
+	}
+
+	fromStatus, fromClaimedBy := res.Status, res.ClaimedBy
+	res.Status = constants.ReportStatuses.CLAIMED
+	res.ClaimedBy = adminPID
+
+	err = s.reportsGorm.Transaction(c, func(tx *gorm.DB) error {
+		var err error
+		res, err = s.reportsGorm.WithTx(tx).UpdateReport(c, res, fromStatus, fromClaimedBy)
+		if err != nil {
+			return errors.Wrap(err, "[ClaimReport][UpdateReport]")
+		}
+		return s.audit(c, tx, constants.AuditActions.REPORT_CLAIMED, constants.AuditEntityTypes.REPORT, res.PID, req.Notes)
+	})
+	if errors.Is(err, reports.ErrReportChanged) {
+		return reportChanged(baseRes), res, nil
+	}
+	if err != nil {
+		return reportFailed(baseRes), res, errors.Wrap(err, "[ClaimReport][Transaction]")
+	}
+
+	baseRes.Message = "report claimed successfully"
+	return baseRes, res, err
+}
+
+func (s *reportSvcImpl) ResolveReport(c *gin.Context, req ReportActionReq) (utils.BaseResponse, tables.Reports, error) {
+	baseRes, res, _, err := s.getReportForAdmin(c, req.ReportPID)
+	if err != nil || baseRes.StatusCode != http.StatusOK {
+		return baseRes, res, err
+	}
+
+	if req.Action == "" {
+		req.Action = constants.ReportActions.NONE
+	}
+
+	switch req.Action {
+	case constants.ReportActions.NONE:
+	case constants.ReportActions.SUSPEND_USER:
+		if res.TargetType != constants.ReportTargetTypes.MEMBER {
+			baseRes.Success = false
+			baseRes.StatusCode = http.StatusUnprocessableEntity
+			baseRes.Message = "only reported members can be suspended"
+			return baseRes, res, err
+		}
+	default:
+		baseRes.Success = false
+		baseRes.StatusCode = http.StatusUnprocessableEntity
+		baseRes.Message = "invalid report action"
+		return baseRes, res, err
+	}
+
+	// the suspension, the closed report and their audit entries land together or not at all
+	err = s.reportsGorm.Transaction(c, func(tx *gorm.DB) error {
+		var err error
+		if req.Action == constants.ReportActions.SUSPEND_USER {
+			err = s.suspendMember(c, tx, res)
+			if err != nil {
+				return err
+			}
+		}
+		res, err = s.closeReport(c, tx, res, constants.ReportStatuses.RESOLVED, req)
+		return err
+	})
+	if errors.Is(err, errMemberNotFound) {
+		baseRes.Success = false
+		baseRes.StatusCode = http.StatusNotFound
+		baseRes.Message = "reported member no longer exists"
+		return baseRes, res, nil
+	}
+	if errors.Is(err, reports.ErrReportChanged) {
+		return reportChanged(baseRes), res, nil
+	}
+	if err != nil {
+		return reportFailed(baseRes), res, errors.Wrap(err, "[ResolveReport][Transaction]")
+	}
+
+	baseRes.Message = "report resolved successfully"
+	return baseRes, res, err
+}
+
+func (s *reportSvcImpl) DismissReport(c *gin.Context, req ReportActionReq) (utils.BaseResponse, tables.Reports, error) {
+	baseRes, res, _, err := s.getReportForAdmin(c, req.ReportPID)
+	if err != nil || baseRes.StatusCode != http.StatusOK {
+		return baseRes, res, err
+	}
+
+	req.Action = constants.ReportActions.NONE
+
+	err = s.reportsGorm.Transaction(c, func(tx *gorm.DB) error {
+		var err error
+		res, err = s.closeReport(c, tx, res, constants.ReportStatuses.DISMISSED, req)
+		return err
+	})
+	if errors.Is(err, reports.ErrReportChanged) {
+		return reportChanged(baseRes), res, nil
+	}
+	if err != nil {
+		return reportFailed(baseRes), res, errors.Wrap(err, "[DismissReport][Transaction]")
+	}
+
+	baseRes.Message = "report dismissed successfully"
+	return baseRes, res, err
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                   Helpers                                  */
+/* -------------------------------------------------------------------------- */
+
+var errMemberNotFound = errors.New("reported member not found")
+
+// getReportForAdmin loads a report the calling admin may still act on: it must
+// not be closed, and if it is claimed it must be claimed by them. The update
+// that follows re-checks this, so a concurrent change by another admin is a 409.
+func (s *reportSvcImpl) getReportForAdmin(c *gin.Context, reportPID string) (utils.BaseResponse, tables.Reports, string, error) {
+	var baseRes utils.BaseResponse
+	var res tables.Reports
+	var err error
+
+	// Add initial Response
+	baseRes.Success = false
+	baseRes.StatusCode = http.StatusInternalServerError
+	baseRes.Message = "Internal Server Error"
+
+	authData, err := utils.GetAuthData(c)
+	if err != nil {
+		return baseRes, res, "", errors.Wrap(err, "[getReportForAdmin][GetAuthData]")
+	}
+
+	res, err = s.reportsGorm.GetReportByPID(c, reportPID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		baseRes.StatusCode = http.StatusNotFound
+		baseRes.Message = "report not found"
+		return baseRes, res, "", nil
+	}
+	if err != nil {
+		return baseRes, res, "", errors.Wrap(err, "[getReportForAdmin][GetReportByPID]")
+	}
+
+	switch {
+	case res.Status == constants.ReportStatuses.RESOLVED || res.Status == constants.ReportStatuses.DISMISSED:
+		baseRes.StatusCode = http.StatusConflict
+		baseRes.Message = "report is already closed"
+		return baseRes, res, "", err
+	case res.Status == constants.ReportStatuses.CLAIMED && res.ClaimedBy != authData.AdminPID:
+		baseRes.StatusCode = http.StatusConflict
+		baseRes.Message = "report is claimed by another admin"
+		return baseRes, res, "", err
+	}
+
+	baseRes.Success = true
+	baseRes.StatusCode = http.StatusOK
+	return baseRes, res, authData.AdminPID, err
+}
+
+func reportChanged(baseRes utils.BaseResponse) utils.BaseResponse {
+	baseRes.Success = false
+	baseRes.StatusCode = http.StatusConflict
+	baseRes.Message = "report was changed by another admin, reload it"
+	return baseRes
+}
+
+// reportFailed undoes the OK getReportForAdmin set once the update itself fails
+func reportFailed(baseRes utils.BaseResponse) utils.BaseResponse {
+	baseRes.Success = false
+	baseRes.StatusCode = http.StatusInternalServerError
+	baseRes.Message = "Internal Server Error"
+	return baseRes
+}
+
+// suspendMember suspends the reported user, or revokes the reported guest since
+// members are users or room guests
+func (s *reportSvcImpl) suspendMember(c *gin.Context, tx *gorm.DB, report tables.Reports) error {
+	auditAction := constants.AuditActions.USER_SUSPENDED
+	auditEntityType := constants.AuditEntityTypes.USER
+	err := s.usersGorm.WithTx(tx).SuspendUser(c, report.TargetPID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		auditAction = constants.AuditActions.GUEST_REVOKED
+		auditEntityType = constants.AuditEntityTypes.GUEST
+		err = s.guestsGorm.WithTx(tx).RevokeGuest(c, report.TargetPID)
+	}
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return errMemberNotFound
+	}
+	if err != nil {
+		return errors.Wrap(err, "[suspendMember][SuspendUser]")
+	}
+
+	return s.audit(c, tx, auditAction, auditEntityType, report.TargetPID, "report "+report.PID)
+}
+
+func (s *reportSvcImpl) closeReport(c *gin.Context, tx *gorm.DB, report tables.Reports, status string, req ReportActionReq) (tables.Reports, error) {
+	authData, err := utils.GetAuthData(c)
+	if err != nil {
+		return report, err
+	}
+
+	fromStatus, fromClaimedBy := report.Status, report.ClaimedBy
+	closedAt := time.Now()
+	report.Status = status
+	report.ClaimedBy = authData.AdminPID
+	report.ResolutionAction = req.Action
+	report.ResolutionNotes = req.Notes
+	report.ClosedAt = &closedAt
+
+	report, err = s.reportsGorm.WithTx(tx).UpdateReport(c, report, fromStatus, fromClaimedBy)
+	if err != nil {
+		return report, err
+	}
+
+	action := constants.AuditActions.REPORT_RESOLVED
+	if status == constants.ReportStatuses.DISMISSED {
+		action = constants.AuditActions.REPORT_DISMISSED
+	}
+	return report, s.audit(c, tx, action, constants.AuditEntityTypes.REPORT, report.PID, req.Notes)
+}
+
+func (s *reportSvcImpl) audit(c *gin.Context, tx *gorm.DB, action string, entityType string, entityPID string, notes string) error {
+	var auditLog tables.AuditLogs
+	auditLog.Action = action
+	auditLog.EntityType = entityType
+	auditLog.EntityPID = entityPID
+	auditLog.Notes = notes
+
+	_, err := s.auditLogsGorm.WithTx(tx).CreateAuditLog(c, auditLog)
+	return err
+}
@@ -0,0 +1,297 @@
+package reportsvc
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/BearTS/go-gin-monolith/config"
+	"github.com/BearTS/go-gin-monolith/constants"
+	"github.com/BearTS/go-gin-monolith/database/tables"
+	"github.com/BearTS/go-gin-monolith/dbops/gorm/audit_logs"
+	"github.com/BearTS/go-gin-monolith/dbops/gorm/guests"
+	"github.com/BearTS/go-gin-monolith/dbops/gorm/reports"
+	"github.com/BearTS/go-gin-monolith/dbops/gorm/users"
+	"github.com/BearTS/go-gin-monolith/models"
+	"github.com/BearTS/go-gin-monolith/services/authsvc"
+	"github.com/BearTS/go-gin-monolith/utils"
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+/* -------------------------------------------------------------------------- */
+/*                                    Fakes                                   */
+/* -------------------------------------------------------------------------- */
+
+// fakeStore is the state behind every fake gorm layer. Transaction snapshots it
+// and puts the snapshot back when fn fails, the way a rollback would.
+type fakeStore struct {
+	reports   map[string]tables.Reports
+	auditLogs []tables.AuditLogs
+	users     map[string]bool // pid -> suspended
+	guests    map[string]bool // pid -> revoked
+}
+
+func (s *fakeStore) snapshot() fakeStore {
+	cp := fakeStore{
+		reports:   map[string]tables.Reports{},
+		auditLogs: append([]tables.AuditLogs(nil), s.auditLogs...),
+		users:     map[string]bool{},
+		guests:    map[string]bool{},
+	}
+	for k, v := range s.reports {
+		cp.reports[k] = v
+	}
+	for k, v := range s.users {
+		cp.users[k] = v
+	}
+	for k, v := range s.guests {
+		cp.guests[k] = v
+	}
+	return cp
+}
+
+type fakeReportsGorm struct {
+	reports.GormInterface
+	store *fakeStore
+	// changedMeanwhile makes UpdateReport lose the race with another admin
+	changedMeanwhile bool
+}
+
+func (f *fakeReportsGorm) GetReportByPID(ctx *gin.Context, pid string) (tables.Reports, error) {
+	report, ok := f.store.reports[pid]
+	if !ok {
+		return report, gorm.ErrRecordNotFound
+	}
+	return report, nil
+}
+
+func (f *fakeReportsGorm) UpdateReport(ctx *gin.Context, report tables.Reports, fromStatus string, fromClaimedBy string) (tables.Reports, error) {
+	current := f.store.reports[report.PID]
+	if f.changedMeanwhile || current.Status != fromStatus || current.ClaimedBy != fromClaimedBy {
+		return report, reports.ErrReportChanged
+	}
+	f.store.reports[report.PID] = report
+	return report, nil
+}
+
+func (f *fakeReportsGorm) Transaction(ctx *gin.Context, fn func(tx *gorm.DB) error) error {
+	before := f.store.snapshot()
+	err := fn(nil)
+	if err != nil {
+		*f.store = before
+	}
+	return err
+}
+
+func (f *fakeReportsGorm) WithTx(tx *gorm.DB) reports.GormInterface {
+	return f
+}
+
+type fakeAuditLogsGorm struct {
+	audit_logs.GormInterface
+	store *fakeStore
+	fail  bool
+}
+
+func (f *fakeAuditLogsGorm) CreateAuditLog(ctx *gin.Context, auditLog tables.AuditLogs) (tables.AuditLogs, error) {
+	if f.fail {
+		return auditLog, errors.New("audit log insert failed")
+	}
+	f.store.auditLogs = append(f.store.auditLogs, auditLog)
+	return auditLog, nil
+}
+
+func (f *fakeAuditLogsGorm) GetAuditLogsByEntityPID(ctx *gin.Context, entityPID string) ([]tables.AuditLogs, error) {
+	var res []tables.AuditLogs
+	for _, l := range f.store.auditLogs {
+		if l.EntityPID == entityPID {
+			res = append(res, l)
+		}
+	}
+	return res, nil
+}
+
+func (f *fakeAuditLogsGorm) WithTx(tx *gorm.DB) audit_logs.GormInterface {
+	return f
+}
+
+type fakeUsersGorm struct {
+	users.GormInterface
+	store *fakeStore
+	fail  bool
+}
+
+func (f *fakeUsersGorm) SuspendUser(ctx *gin.Context, pid string) error {
+	if f.fail {
+		return errors.New("suspend failed")
+	}
+	if _, ok := f.store.users[pid]; !ok {
+		return gorm.ErrRecordNotFound
+	}
+	f.store.users[pid] = true
+	return nil
+}
+
+func (f *fakeUsersGorm) WithTx(tx *gorm.DB) users.GormInterface {
+	return f
+}
+
+type fakeGuestsGorm struct {
+	guests.GormInterface
+	store *fakeStore
+}
+
+func (f *fakeGuestsGorm) RevokeGuest(ctx *gin.Context, pid string) error {
+	if _, ok := f.store.guests[pid]; !ok {
+		return gorm.ErrRecordNotFound
+	}
+	f.store.guests[pid] = true
+	return nil
+}
+
+func (f *fakeGuestsGorm) WithTx(tx *gorm.DB) guests.GormInterface {
+	return f
+}
+
+type reportTest struct {
+	svc           Interface
+	store         *fakeStore
+	reportsGorm   *fakeReportsGorm
+	auditLogsGorm *fakeAuditLogsGorm
+	usersGorm     *fakeUsersGorm
+	ctx           *gin.Context
+}
+
+func newReportTest(t *testing.T) reportTest {
+	config.Token = &config.TokenConfig{AccessSecret: "test-access", RefreshSecret: "test-refresh"}
+
+	store := &fakeStore{
+		reports: map[string]tables.Reports{
+			"rpt_user":  {PID: "rpt_user", TargetType: constants.ReportTargetTypes.MEMBER, TargetPID: "usr_1", Status: constants.ReportStatuses.OPEN},
+			"rpt_guest": {PID: "rpt_guest", TargetType: constants.ReportTargetTypes.MEMBER, TargetPID: "gst_1", Status: constants.ReportStatuses.OPEN},
+		},
+		users:  map[string]bool{"usr_1": false},
+		guests: map[string]bool{"gst_1": false},
+	}
+	rt := reportTest{
+		store:         store,
+		reportsGorm:   &fakeReportsGorm{store: store},
+		auditLogsGorm: &fakeAuditLogsGorm{store: store},
+		usersGorm:     &fakeUsersGorm{store: store},
+	}
+	rt.svc = Handler(rt.reportsGorm, rt.auditLogsGorm, rt.usersGorm, &fakeGuestsGorm{store: store})
+
+	td, err := authsvc.Handler(nil, nil).CreateToken(models.AuthData{Type: constants.TokenTypes.ADMIN, AdminPID: "adm_1"})
+	require.NoError(t, err)
+	rt.ctx, _ = gin.CreateTestContext(httptest.NewRecorder())
+	rt.ctx.Request = httptest.NewRequest(http.MethodPost, "/", nil)
+	rt.ctx.Request.Header.Set("Authorization", td.AccessToken)
+
+	return rt
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                    Tests                                   */
+/* -------------------------------------------------------------------------- */
+
+func TestReportNotFound(t *testing.T) {
+	rt := newReportTest(t)
+	req := ReportActionReq{ReportPID: "rpt_unknown"}
+
+	baseRes, _, err := rt.svc.GetReport(rt.ctx, req.ReportPID)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, baseRes.StatusCode)
+
+	for name, action := range map[string]func(*gin.Context, ReportActionReq) (utils.BaseResponse, tables.Reports, error){
+		"claim":   rt.svc.ClaimReport,
+		"resolve": rt.svc.ResolveReport,
+		"dismiss": rt.svc.DismissReport,
+	} {
+		baseRes, _, err := action(rt.ctx, req)
+		require.NoError(t, err, name)
+		assert.Equal(t, http.StatusNotFound, baseRes.StatusCode, name)
+	}
+	assert.Empty(t, rt.store.auditLogs)
+}
+
+func TestClaimReportChangedMeanwhile(t *testing.T) {
+	rt := newReportTest(t)
+	rt.reportsGorm.changedMeanwhile = true
+
+	baseRes, _, err := rt.svc.ClaimReport(rt.ctx, ReportActionReq{ReportPID: "rpt_user"})
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusConflict, baseRes.StatusCode)
+	assert.Equal(t, constants.ReportStatuses.OPEN, rt.store.reports["rpt_user"].Status)
+	assert.Empty(t, rt.store.auditLogs)
+}
+
+func TestResolveReportSuspendsUser(t *testing.T) {
+	rt := newReportTest(t)
+
+	baseRes, res, err := rt.svc.ResolveReport(rt.ctx, ReportActionReq{ReportPID: "rpt_user", Action: constants.ReportActions.SUSPEND_USER})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, baseRes.StatusCode, baseRes.Message)
+
+	assert.Equal(t, constants.ReportStatuses.RESOLVED, res.Status)
+	assert.True(t, rt.store.users["usr_1"])
+	require.Len(t, rt.store.auditLogs, 2)
+	assert.Equal(t, constants.AuditActions.USER_SUSPENDED, rt.store.auditLogs[0].Action)
+	assert.Equal(t, constants.AuditActions.REPORT_RESOLVED, rt.store.auditLogs[1].Action)
+}
+
+func TestResolveReportRevokesGuest(t *testing.T) {
+	rt := newReportTest(t)
+
+	// gst_1 is not a user, so SuspendUser misses and the guest is revoked instead
+	baseRes, _, err := rt.svc.ResolveReport(rt.ctx, ReportActionReq{ReportPID: "rpt_guest", Action: constants.ReportActions.SUSPEND_USER})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, baseRes.StatusCode, baseRes.Message)
+
+	assert.True(t, rt.store.guests["gst_1"])
+	require.Len(t, rt.store.auditLogs, 2)
+	assert.Equal(t, constants.AuditActions.GUEST_REVOKED, rt.store.auditLogs[0].Action)
+}
+
+func TestResolveReportMemberGone(t *testing.T) {
+	rt := newReportTest(t)
+	delete(rt.store.guests, "gst_1")
+
+	baseRes, _, err := rt.svc.ResolveReport(rt.ctx, ReportActionReq{ReportPID: "rpt_guest", Action: constants.ReportActions.SUSPEND_USER})
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, baseRes.StatusCode)
+	assert.Equal(t, constants.ReportStatuses.OPEN, rt.store.reports["rpt_guest"].Status)
+}
+
+func TestResolveReportRollsBack(t *testing.T) {
+	cases := map[string]func(rt reportTest){
+		"audit log fails":  func(rt reportTest) { rt.auditLogsGorm.fail = true },
+		"suspension fails": func(rt reportTest) { rt.usersGorm.fail = true },
+		"report changed":   func(rt reportTest) { rt.reportsGorm.changedMeanwhile = true },
+	}
+	for name, breakIt := range cases {
+		rt := newReportTest(t)
+		breakIt(rt)
+
+		baseRes, _, _ := rt.svc.ResolveReport(rt.ctx, ReportActionReq{ReportPID: "rpt_user", Action: constants.ReportActions.SUSPEND_USER})
+		assert.NotEqual(t, http.StatusOK, baseRes.StatusCode, name)
+
+		// neither the suspension nor the closed report survive
+		assert.False(t, rt.store.users["usr_1"], name)
+		assert.Equal(t, constants.ReportStatuses.OPEN, rt.store.reports["rpt_user"].Status, name)
+		assert.Empty(t, rt.store.auditLogs, name)
+	}
+}
+
+func TestDismissReportRollsBack(t *testing.T) {
+	rt := newReportTest(t)
+	rt.auditLogsGorm.fail = true
+
+	baseRes, _, err := rt.svc.DismissReport(rt.ctx, ReportActionReq{ReportPID: "rpt_user"})
+	assert.Error(t, err)
+	assert.Equal(t, http.StatusInternalServerError, baseRes.StatusCode)
+	assert.Equal(t, constants.ReportStatuses.OPEN, rt.store.reports["rpt_user"].Status)
+}
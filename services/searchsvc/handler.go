@@ -0,0 +1,44 @@
+package searchsvc
+
+import (
+	"time"
+
+	"github.com/BearTS/go-gin-monolith/config"
+	"github.com/BearTS/go-gin-monolith/spotify"
+	"github.com/BearTS/go-gin-monolith/utils"
+	"github.com/gin-gonic/gin"
+)
+
+const defaultCacheTTL = time.Minute
+
+// Cache is the part of redis.Connection search needs
+type Cache interface {
+	Get(key string) (string, error)
+	SetWithTimeout(key string, value string, timeout time.Duration) error
+}
+
+type searchSvcImpl struct {
+	spotify  spotify.Interface
+	cache    Cache
+	cacheTTL time.Duration
+	inFlight *inFlight
+}
+
+// interface
+type Interface interface {
+	Search(c *gin.Context, req SearchReq) (utils.BaseResponse, SearchRes, error)
+}
+
+func Handler(spotifyClient spotify.Interface, cache Cache) Interface {
+	ttl := time.Duration(config.Spotify.SearchCacheTtlSeconds) * time.Second
+	if ttl <= 0 {
+		ttl = defaultCacheTTL
+	}
+
+	return &searchSvcImpl{
+		spotify:  spotifyClient,
+		cache:    cache,
+		cacheTTL: ttl,
+		inFlight: newInFlight(),
+	}
+}
@@ -0,0 +1,48 @@
+package searchsvc
+
+import (
+	"errors"
+	"sync"
+)
+
+// errSearchAborted is what waiters see when the shared search never returned
+var errSearchAborted = errors.New("search aborted")
+
+// inFlight lets identical concurrent searches share one upstream call
+type inFlight struct {
+	mu    sync.Mutex
+	calls map[string]*call
+}
+
+type call struct {
+	done chan struct{}
+	res  SearchRes
+	err  error
+}
+
+func newInFlight() *inFlight {
+	return &inFlight{calls: map[string]*call{}}
+}
+
+func (f *inFlight) do(key string, fn func() (SearchRes, error)) (SearchRes, error) {
+	f.mu.Lock()
+	if c, ok := f.calls[key]; ok {
+		f.mu.Unlock()
+		<-c.done
+		return c.res, c.err
+	}
+	c := &call{done: make(chan struct{}), err: errSearchAborted}
+	f.calls[key] = c
+	f.mu.Unlock()
+
+	// release the key however fn ends, a panic included, so waiters never hang
+	defer func() {
+		f.mu.Lock()
+		delete(f.calls, key)
+		f.mu.Unlock()
+		close(c.done)
+	}()
+
+	c.res, c.err = fn()
+	return c.res, c.err
+}
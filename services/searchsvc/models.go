@@ -0,0 +1,45 @@
+package searchsvc
+
+type SearchReq struct {
+	Query  string `form:"q" binding:"required"`
+	Type   string `form:"type"` // comma separated, track by default
+	Market string `form:"market"`
+	Cursor string `form:"cursor"`
+}
+
+type SearchRes struct {
+	// the normalized query, so clients can drop answers to keystrokes they've moved past
+	Query      string      `json:"query"`
+	Tracks     []TrackRes  `json:"tracks,omitempty"`
+	Artists    []ArtistRes `json:"artists,omitempty"`
+	Albums     []AlbumRes  `json:"albums,omitempty"`
+	NextCursor string      `json:"next_cursor,omitempty"`
+}
+
+type TrackRes struct {
+	SpotifyID  string   `json:"spotify_id"`
+	Name       string   `json:"name"`
+	Artists    []string `json:"artists"`
+	AlbumName  string   `json:"album_name"`
+	ImageURL   string   `json:"image_url,omitempty"`
+	DurationMs int      `json:"duration_ms"`
+	Explicit   bool     `json:"explicit"`
+	URI        string   `json:"uri"`
+}
+
+type ArtistRes struct {
+	SpotifyID string   `json:"spotify_id"`
+	Name      string   `json:"name"`
+	Genres    []string `json:"genres"`
+	ImageURL  string   `json:"image_url,omitempty"`
+	URI       string   `json:"uri"`
+}
+
+type AlbumRes struct {
+	SpotifyID   string   `json:"spotify_id"`
+	Name        string   `json:"name"`
+	Artists     []string `json:"artists"`
+	ReleaseDate string   `json:"release_date"`
+	ImageURL    string   `json:"image_url,omitempty"`
+	URI         string   `json:"uri"`
+}
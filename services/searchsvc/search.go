@@ -0,0 +1,255 @@
+package searchsvc
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"log"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/BearTS/go-gin-monolith/spotify"
+	"github.com/BearTS/go-gin-monolith/utils"
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+)
+
+const (
+	pageSize = 20
+
+	// spotify refuses offsets past this
+	maxOffset = 1000
+
+	upstreamTimeout = 10 * time.Second
+)
+
+var searchTypes = map[string]bool{"track": true, "artist": true, "album": true}
+
+func (s *searchSvcImpl) Search(c *gin.Context, req SearchReq) (utils.BaseResponse, SearchRes, error) {
+	var baseRes utils.BaseResponse
+	var res SearchRes
+	var err error
+
+	// Add initial Response
+	baseRes.Success = false
+	baseRes.StatusCode = http.StatusUnprocessableEntity
+
+	query := normalizeQuery(req.Query)
+	if query == "" {
+		baseRes.Message = "search query is empty"
+		return baseRes, res, err
+	}
+
+	types, ok := parseTypes(req.Type)
+	if !ok {
+		baseRes.Message = "type must be any of track, artist and album"
+		return baseRes, res, err
+	}
+
+	market := strings.ToUpper(strings.TrimSpace(req.Market))
+	if market != "" && len(market) != 2 {
+		baseRes.Message = "market must be a two letter country code"
+		return baseRes, res, err
+	}
+
+	offset, ok := decodeCursor(req.Cursor)
+	if !ok {
+		baseRes.Message = "invalid cursor"
+		return baseRes, res, err
+	}
+
+	key := cacheKey(query, types, market, offset)
+
+	if cached, err := s.cache.Get(key); err == nil {
+		if err := json.Unmarshal([]byte(cached), &res); err == nil {
+			baseRes.Success = true
+			baseRes.StatusCode = http.StatusOK
+			return baseRes, res, nil
+		}
+	}
+
+	res, err = s.inFlight.do(key, func() (SearchRes, error) {
+		return s.searchUpstream(key, query, types, market, offset)
+	})
+	if err != nil {
+		var apiErr *spotify.APIError
+		if errors.As(err, &apiErr) {
+			baseRes.StatusCode = 550
+			baseRes.Message = "search is unavailable right now"
+			return baseRes, res, nil
+		}
+		baseRes.StatusCode = http.StatusInternalServerError
+		baseRes.Message = "Internal Server Error"
+		return baseRes, res, errors.Wrap(err, "[Search][searchUpstream]")
+	}
+
+	baseRes.Success = true
+	baseRes.StatusCode = http.StatusOK
+	return baseRes, res, err
+}
+
+// searchUpstream is shared by every caller waiting on the same key, so it
+// doesn't use any one request's context
+func (s *searchSvcImpl) searchUpstream(key string, query string, types []string, market string, offset int) (SearchRes, error) {
+	var res SearchRes
+
+	ctx, cancel := context.WithTimeout(context.Background(), upstreamTimeout)
+	defer cancel()
+
+	result, err := s.spotify.Search(ctx, query, types, market, pageSize, offset)
+	if err != nil {
+		return res, err
+	}
+
+	res = searchTransformer(query, result)
+	if hasMore(result, offset) && offset+pageSize < maxOffset {
+		res.NextCursor = encodeCursor(offset + pageSize)
+	}
+
+	value, err := json.Marshal(res)
+	if err == nil {
+		err = s.cache.SetWithTimeout(key, string(value), s.cacheTTL)
+	}
+	if err != nil {
+		// a cold cache only costs an extra upstream call
+		log.Println("[searchUpstream] caching search results failed: ", err)
+	}
+
+	return res, nil
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                   Helpers                                  */
+/* -------------------------------------------------------------------------- */
+
+// normalizeQuery makes "Daft  Punk " and "daft punk" share a cache entry
+func normalizeQuery(query string) string {
+	return strings.Join(strings.Fields(strings.ToLower(query)), " ")
+}
+
+func parseTypes(raw string) ([]string, bool) {
+	if strings.TrimSpace(raw) == "" {
+		return []string{"track"}, true
+	}
+
+	seen := map[string]bool{}
+	var types []string
+	for _, t := range strings.Split(raw, ",") {
+		t = strings.ToLower(strings.TrimSpace(t))
+		if !searchTypes[t] {
+			return nil, false
+		}
+		if !seen[t] {
+			seen[t] = true
+			types = append(types, t)
+		}
+	}
+	sort.Strings(types)
+	return types, true
+}
+
+func cacheKey(query string, types []string, market string, offset int) string {
+	sum := sha1.Sum([]byte(strings.Join(types, ",") + "|" + market + "|" + strconv.Itoa(offset) + "|" + query))
+	return "search:" + hex.EncodeToString(sum[:])
+}
+
+// cursors are opaque to clients, they only wrap the offset
+func encodeCursor(offset int) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.Itoa(offset)))
+}
+
+func decodeCursor(cursor string) (int, bool) {
+	if cursor == "" {
+		return 0, true
+	}
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return 0, false
+	}
+	offset, err := strconv.Atoi(string(raw))
+	if err != nil || offset < 0 || offset >= maxOffset {
+		return 0, false
+	}
+	return offset, true
+}
+
+func hasMore(result spotify.SearchResult, offset int) bool {
+	next := offset + pageSize
+	return (result.Tracks != nil && result.Tracks.Total > next) ||
+		(result.Artists != nil && result.Artists.Total > next) ||
+		(result.Albums != nil && result.Albums.Total > next)
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                Transformers                                */
+/* -------------------------------------------------------------------------- */
+
+func searchTransformer(query string, result spotify.SearchResult) SearchRes {
+	var res SearchRes
+	res.Query = query
+
+	if result.Tracks != nil {
+		res.Tracks = []TrackRes{}
+		for _, track := range result.Tracks.Items {
+			res.Tracks = append(res.Tracks, TrackRes{
+				SpotifyID:  track.ID,
+				Name:       track.Name,
+				Artists:    artistNames(track.Artists),
+				AlbumName:  track.Album.Name,
+				ImageURL:   firstImage(track.Album.Images),
+				DurationMs: track.DurationMs,
+				Explicit:   track.Explicit,
+				URI:        track.URI,
+			})
+		}
+	}
+
+	if result.Artists != nil {
+		res.Artists = []ArtistRes{}
+		for _, artist := range result.Artists.Items {
+			res.Artists = append(res.Artists, ArtistRes{
+				SpotifyID: artist.ID,
+				Name:      artist.Name,
+				Genres:    artist.Genres,
+				ImageURL:  firstImage(artist.Images),
+				URI:       artist.URI,
+			})
+		}
+	}
+
+	if result.Albums != nil {
+		res.Albums = []AlbumRes{}
+		for _, album := range result.Albums.Items {
+			res.Albums = append(res.Albums, AlbumRes{
+				SpotifyID:   album.ID,
+				Name:        album.Name,
+				Artists:     artistNames(album.Artists),
+				ReleaseDate: album.ReleaseDate,
+				ImageURL:    firstImage(album.Images),
+				URI:         album.URI,
+			})
+		}
+	}
+
+	return res
+}
+
+func artistNames(artists []spotify.SimpleArtist) []string {
+	names := []string{}
+	for _, artist := range artists {
+		names = append(names, artist.Name)
+	}
+	return names
+}
+
+func firstImage(images []spotify.Image) string {
+	if len(images) == 0 {
+		return ""
+	}
+	return images[0].URL
+}
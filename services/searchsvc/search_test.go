@@ -0,0 +1,159 @@
+package searchsvc
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/BearTS/go-gin-monolith/spotify"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+/* -------------------------------------------------------------------------- */
+/*                                    Fakes                                   */
+/* -------------------------------------------------------------------------- */
+
+type fakeCache struct {
+	mu   sync.Mutex
+	data map[string]string
+}
+
+func (f *fakeCache) Get(key string) (string, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	value, ok := f.data[key]
+	if !ok {
+		return "", errors.New("miss")
+	}
+	return value, nil
+}
+
+func (f *fakeCache) SetWithTimeout(key string, value string, timeout time.Duration) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.data[key] = value
+	return nil
+}
+
+type fakeSpotify struct {
+	spotify.Interface
+	calls int32
+}
+
+func (f *fakeSpotify) Search(ctx context.Context, query string, types []string, market string, limit int, offset int) (spotify.SearchResult, error) {
+	atomic.AddInt32(&f.calls, 1)
+	time.Sleep(20 * time.Millisecond)
+
+	return spotify.SearchResult{Tracks: &spotify.TrackPage{
+		Items: []spotify.Track{{ID: "t1", Name: query}},
+		Total: 45,
+	}}, nil
+}
+
+func testSearch(upstream *fakeSpotify) *searchSvcImpl {
+	return &searchSvcImpl{
+		spotify:  upstream,
+		cache:    &fakeCache{data: map[string]string{}},
+		cacheTTL: time.Minute,
+		inFlight: newInFlight(),
+	}
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                    Tests                                   */
+/* -------------------------------------------------------------------------- */
+
+func TestSearchCollapsesAndCachesQueries(t *testing.T) {
+	upstream := &fakeSpotify{}
+	svc := testSearch(upstream)
+
+	var wg sync.WaitGroup
+	for _, q := range []string{"daft punk", "Daft  Punk", " DAFT punk ", "daft punk"} {
+		wg.Add(1)
+		go func(q string) {
+			defer wg.Done()
+			baseRes, res, err := svc.Search(&gin.Context{}, SearchReq{Query: q})
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusOK, baseRes.StatusCode)
+			assert.Equal(t, "daft punk", res.Query)
+		}(q)
+	}
+	wg.Wait()
+
+	_, _, err := svc.Search(&gin.Context{}, SearchReq{Query: "daft punk", Type: "track"})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1), upstream.calls)
+}
+
+func TestSearchPaginatesWithCursor(t *testing.T) {
+	svc := testSearch(&fakeSpotify{})
+
+	_, first, err := svc.Search(&gin.Context{}, SearchReq{Query: "q"})
+	assert.NoError(t, err)
+	assert.NotEmpty(t, first.NextCursor)
+
+	_, second, err := svc.Search(&gin.Context{}, SearchReq{Query: "q", Cursor: first.NextCursor})
+	assert.NoError(t, err)
+	assert.NotEmpty(t, second.NextCursor)
+
+	// 45 results, the third page is the last
+	_, third, err := svc.Search(&gin.Context{}, SearchReq{Query: "q", Cursor: second.NextCursor})
+	assert.NoError(t, err)
+	assert.Empty(t, third.NextCursor)
+}
+
+func TestSearchRejectsBadInput(t *testing.T) {
+	svc := testSearch(&fakeSpotify{})
+
+	for _, req := range []SearchReq{
+		{Query: "   "},
+		{Query: "q", Type: "podcast"},
+		{Query: "q", Market: "IND"},
+		{Query: "q", Cursor: "not-a-cursor"},
+	} {
+		baseRes, _, err := svc.Search(&gin.Context{}, req)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusUnprocessableEntity, baseRes.StatusCode, req)
+	}
+}
+
+func TestInFlightReleasesWaitersWhenSearchPanics(t *testing.T) {
+	f := newInFlight()
+	started := make(chan struct{})
+	release := make(chan struct{})
+
+	go func() {
+		defer func() { _ = recover() }()
+		_, _ = f.do("key", func() (SearchRes, error) {
+			close(started)
+			<-release
+			panic("spotify client blew up")
+		})
+	}()
+	<-started
+
+	done := make(chan error)
+	go func() {
+		_, err := f.do("key", func() (SearchRes, error) {
+			return SearchRes{}, nil
+		})
+		done <- err
+	}()
+	// let the second caller start waiting on the first
+	time.Sleep(5 * time.Millisecond)
+	close(release)
+
+	select {
+	case err := <-done:
+		assert.ErrorIs(t, err, errSearchAborted)
+	case <-time.After(time.Second):
+		t.Fatal("waiter still blocked after the shared search panicked")
+	}
+}
@@ -2,6 +2,7 @@ package usersvc
 
 import (
 	"github.com/BearTS/go-gin-monolith/database/tables"
+	"github.com/BearTS/go-gin-monolith/dbops/gorm/guests"
 	"github.com/BearTS/go-gin-monolith/dbops/gorm/otp_verifications"
 	"github.com/BearTS/go-gin-monolith/dbops/gorm/users"
 	"github.com/BearTS/go-gin-monolith/services/authsvc"
@@ -12,6 +13,7 @@ import (
 type UserSvcImpl struct {
 	usersGorm           users.GormInterface
 	otpVerificationGorm otp_verifications.GormInterface
+	guestsGorm          guests.GormInterface
 	authSvc             authsvc.Interface
 }
 
@@ -20,12 +22,14 @@ type Interface interface {
 	SendOTP(c *gin.Context, req SendOTPReq) (utils.BaseResponse, tables.Users, error)
 	VerifyOTP(c *gin.Context, req VerifyOTPReq) (utils.BaseResponse, tables.Users, error)
 	ResendOTP(c *gin.Context, req ResendOTPReq) (utils.BaseResponse, tables.Users, error)
+	RefreshToken(c *gin.Context) (utils.BaseResponse, tables.Users, error)
 }
 
-func Handler(userGorm users.GormInterface, otpVerificationGorm otp_verifications.GormInterface, authSvc authsvc.Interface) Interface {
+func Handler(userGorm users.GormInterface, otpVerificationGorm otp_verifications.GormInterface, guestsGorm guests.GormInterface, authSvc authsvc.Interface) Interface {
 	return &UserSvcImpl{
 		usersGorm:           userGorm,
 		otpVerificationGorm: otpVerificationGorm,
+		guestsGorm:          guestsGorm,
 		authSvc:             authSvc,
 	}
 }
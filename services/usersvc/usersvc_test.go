@@ -0,0 +1,201 @@
+package usersvc
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/BearTS/go-gin-monolith/config"
+	"github.com/BearTS/go-gin-monolith/constants"
+	"github.com/BearTS/go-gin-monolith/database/tables"
+	"github.com/BearTS/go-gin-monolith/dbops/gorm/guests"
+	"github.com/BearTS/go-gin-monolith/dbops/gorm/users"
+	"github.com/BearTS/go-gin-monolith/models"
+	"github.com/BearTS/go-gin-monolith/services/authsvc"
+	"github.com/BearTS/go-gin-monolith/utils"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+/* -------------------------------------------------------------------------- */
+/*                                    Fakes                                   */
+/* -------------------------------------------------------------------------- */
+
+type fakeAuthSvc struct {
+	generated []authsvc.TokenReq
+	refreshed []models.AuthData
+}
+
+func (f *fakeAuthSvc) GenerateToken(c *gin.Context, req authsvc.TokenReq) (utils.BaseResponse, authsvc.TokenRes, error) {
+	f.generated = append(f.generated, req)
+	return utils.BaseResponse{Success: true, StatusCode: http.StatusOK}, authsvc.TokenRes{UserID: req.UserID}, nil
+}
+
+func (f *fakeAuthSvc) RefreshToken(c *gin.Context, refreshData models.AuthData) (utils.BaseResponse, authsvc.TokenRes, error) {
+	f.refreshed = append(f.refreshed, refreshData)
+	if refreshData.GuestPID == "gst_ended" {
+		return utils.BaseResponse{StatusCode: http.StatusForbidden, Message: "guest session has ended"}, authsvc.TokenRes{}, nil
+	}
+	return utils.BaseResponse{Success: true, StatusCode: http.StatusOK}, authsvc.TokenRes{GuestID: refreshData.GuestPID, UserID: refreshData.UserPID}, nil
+}
+
+func (f *fakeAuthSvc) CreateToken(tokenAuthData models.AuthData) (*authsvc.TokenDetails, error) {
+	return authsvc.Handler(nil, nil).CreateToken(tokenAuthData)
+}
+
+func (f *fakeAuthSvc) ValidateToken(signedToken string) error {
+	return authsvc.ValidateToken(signedToken)
+}
+
+type fakeOtpGorm struct {
+	otp tables.OtpVerifications
+}
+
+func (f *fakeOtpGorm) CreateNewOTPVerification(ctx *gin.Context, otp tables.OtpVerifications) (tables.OtpVerifications, error) {
+	return otp, nil
+}
+
+func (f *fakeOtpGorm) CreateOTPVerification(ctx *gin.Context, otp tables.OtpVerifications) (tables.OtpVerifications, error) {
+	return otp, nil
+}
+
+func (f *fakeOtpGorm) GetOtpVerificationDetailsByPID(ctx *gin.Context, pid string) (tables.OtpVerifications, error) {
+	return f.otp, nil
+}
+
+func (f *fakeOtpGorm) GetOtpVerificationDetailsByUserPID(ctx *gin.Context, userPID string) (tables.OtpVerifications, error) {
+	return f.otp, nil
+}
+
+func (f *fakeOtpGorm) UpdateOtpVerification(ctx *gin.Context, otp tables.OtpVerifications) (tables.OtpVerifications, error) {
+	f.otp = otp
+	return otp, nil
+}
+
+type fakeUsersGorm struct {
+	users.GormInterface
+	user tables.Users
+}
+
+func (f *fakeUsersGorm) GetUserDetailsByPID(ctx *gin.Context, pid string) (tables.Users, error) {
+	if pid != f.user.PID {
+		return tables.Users{}, gorm.ErrRecordNotFound
+	}
+	return f.user, nil
+}
+
+type fakeGuestsGorm struct {
+	guests.GormInterface
+	linked map[string]string
+}
+
+func (f *fakeGuestsGorm) LinkUser(ctx *gin.Context, guestPID string, userPID string) error {
+	f.linked[guestPID] = userPID
+	return nil
+}
+
+type testSvc struct {
+	svc        Interface
+	authSvc    *fakeAuthSvc
+	guestsGorm *fakeGuestsGorm
+}
+
+func newTestUserSvc() testSvc {
+	config.Token = &config.TokenConfig{AccessSecret: "test-access", RefreshSecret: "test-refresh"}
+
+	authSvc := &fakeAuthSvc{}
+	guestsGorm := &fakeGuestsGorm{linked: map[string]string{}}
+	otpGorm := &fakeOtpGorm{otp: tables.OtpVerifications{OtpValue: "123456", CreatedAt: time.Now()}}
+	usersGorm := &fakeUsersGorm{user: tables.Users{PID: "usr_1"}}
+
+	return testSvc{
+		svc:        Handler(usersGorm, otpGorm, guestsGorm, authSvc),
+		authSvc:    authSvc,
+		guestsGorm: guestsGorm,
+	}
+}
+
+func requestWithToken(token string) *gin.Context {
+	ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+	ctx.Request = httptest.NewRequest(http.MethodPost, "/", nil)
+	if token != "" {
+		ctx.Request.Header.Set("Authorization", token)
+	}
+	return ctx
+}
+
+func guestTokens(t *testing.T) *authsvc.TokenDetails {
+	tokens, err := authsvc.Handler(nil, nil).CreateToken(models.AuthData{
+		Type:     constants.TokenTypes.GUEST,
+		GuestPID: "gst_1",
+		RoomPID:  "rom_1",
+	})
+	require.NoError(t, err)
+	return tokens
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                    Tests                                   */
+/* -------------------------------------------------------------------------- */
+
+func TestVerifyOTPLinksGuestToUser(t *testing.T) {
+	s := newTestUserSvc()
+
+	ctx := requestWithToken(guestTokens(t).AccessToken)
+	baseRes, _, err := s.svc.VerifyOTP(ctx, VerifyOTPReq{UserPID: "usr_1", Otp: "123456"})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, baseRes.StatusCode, baseRes.Message)
+
+	assert.Equal(t, map[string]string{"gst_1": "usr_1"}, s.guestsGorm.linked)
+	require.Len(t, s.authSvc.generated, 1)
+	assert.Equal(t, constants.TokenTypes.USER, s.authSvc.generated[0].Type)
+}
+
+func TestVerifyOTPWithoutGuestTokenLinksNothing(t *testing.T) {
+	s := newTestUserSvc()
+
+	baseRes, _, err := s.svc.VerifyOTP(requestWithToken(""), VerifyOTPReq{UserPID: "usr_1", Otp: "123456"})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, baseRes.StatusCode, baseRes.Message)
+	assert.Empty(t, s.guestsGorm.linked)
+
+	baseRes, _, _ = s.svc.VerifyOTP(requestWithToken(guestTokens(t).AccessToken), VerifyOTPReq{UserPID: "usr_1", Otp: "000000"})
+	assert.Equal(t, http.StatusUnauthorized, baseRes.StatusCode)
+	assert.Empty(t, s.guestsGorm.linked)
+}
+
+func TestRefreshTokenForGuest(t *testing.T) {
+	s := newTestUserSvc()
+
+	baseRes, _, err := s.svc.RefreshToken(requestWithToken(guestTokens(t).RefreshToken))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, baseRes.StatusCode, baseRes.Message)
+
+	require.Len(t, s.authSvc.refreshed, 1)
+	assert.Equal(t, "gst_1", s.authSvc.refreshed[0].GuestPID)
+	assert.Equal(t, "gst_1", baseRes.Data.(authsvc.TokenRes).GuestID)
+}
+
+func TestRefreshTokenRejected(t *testing.T) {
+	s := newTestUserSvc()
+
+	// an access token is signed with the other secret
+	baseRes, _, err := s.svc.RefreshToken(requestWithToken(guestTokens(t).AccessToken))
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusUnauthorized, baseRes.StatusCode)
+	assert.Empty(t, s.authSvc.refreshed)
+
+	// authsvc refusing the guest is passed on as is
+	tokens, err := authsvc.Handler(nil, nil).CreateToken(models.AuthData{
+		Type:     constants.TokenTypes.GUEST,
+		GuestPID: "gst_ended",
+		RoomPID:  "rom_1",
+	})
+	require.NoError(t, err)
+	baseRes, _, err = s.svc.RefreshToken(requestWithToken(tokens.RefreshToken))
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusForbidden, baseRes.StatusCode)
+}
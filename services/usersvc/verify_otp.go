@@ -93,6 +93,15 @@ func (g *UserSvcImpl) VerifyOTP(c *gin.Context, req VerifyOTPReq) (utils.BaseRes
 		return baseRes, res, err
 	}
 
+	// a guest verifying an email upgrades to this user and keeps their room activity
+	guestData, err := utils.GetAuthData(c)
+	if err == nil && guestData.Type == constants.TokenTypes.GUEST {
+		err = g.guestsGorm.LinkUser(c, guestData.GuestPID, user.PID)
+		if err != nil {
+			return baseRes, res, err
+		}
+	}
+
 	var authData authsvc.TokenReq
 	authData.UserID = user.PID
 
@@ -114,11 +123,15 @@ func (g *UserSvcImpl) VerifyOTP(c *gin.Context, req VerifyOTPReq) (utils.BaseRes
 	// }
 
 	authData.Type = constants.TokenTypes.USER
-	_, token, err := g.authSvc.GenerateToken(c, authData)
+	tokenBaseRes, token, err := g.authSvc.GenerateToken(c, authData)
 	if err != nil {
 		return baseRes, res, err
 	}
 
+	if tokenBaseRes.StatusCode != http.StatusOK {
+		return tokenBaseRes, res, err
+	}
+
 	// Add Success Response
 	baseRes.Success = true
 	baseRes.StatusCode = http.StatusOK
@@ -0,0 +1,115 @@
+package spotify
+
+/* -------------------------------------------------------------------------- */
+/*                              Spotify Web API                               */
+/* -------------------------------------------------------------------------- */
+
+type Image struct {
+	URL    string `json:"url"`
+	Height int    `json:"height"`
+	Width  int    `json:"width"`
+}
+
+type SimpleArtist struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	URI  string `json:"uri"`
+}
+
+type SimpleAlbum struct {
+	ID          string         `json:"id"`
+	Name        string         `json:"name"`
+	AlbumType   string         `json:"album_type"`
+	ReleaseDate string         `json:"release_date"`
+	Images      []Image        `json:"images"`
+	Artists     []SimpleArtist `json:"artists"`
+	URI         string         `json:"uri"`
+}
+
+type Track struct {
+	ID               string         `json:"id"`
+	Name             string         `json:"name"`
+	DurationMs       int            `json:"duration_ms"`
+	Popularity       int            `json:"popularity"`
+	Explicit         bool           `json:"explicit"`
+	URI              string         `json:"uri"`
+	Album            SimpleAlbum    `json:"album"`
+	Artists          []SimpleArtist `json:"artists"`
+	AvailableMarkets []string       `json:"available_markets"`
+}
+
+type Artist struct {
+	ID         string   `json:"id"`
+	Name       string   `json:"name"`
+	Genres     []string `json:"genres"`
+	Images     []Image  `json:"images"`
+	Popularity int      `json:"popularity"`
+	URI        string   `json:"uri"`
+}
+
+type Album struct {
+	ID               string         `json:"id"`
+	Name             string         `json:"name"`
+	AlbumType        string         `json:"album_type"`
+	ReleaseDate      string         `json:"release_date"`
+	Images           []Image        `json:"images"`
+	Artists          []SimpleArtist `json:"artists"`
+	Genres           []string       `json:"genres"`
+	AvailableMarkets []string       `json:"available_markets"`
+	Popularity       int            `json:"popularity"`
+	URI              string         `json:"uri"`
+}
+
+// multi-ID endpoints return null in place of IDs they don't know
+type tracksRes struct {
+	Tracks []*Track `json:"tracks"`
+}
+
+type artistsRes struct {
+	Artists []*Artist `json:"artists"`
+}
+
+type albumsRes struct {
+	Albums []*Album `json:"albums"`
+}
+
+type tokenRes struct {
+	AccessToken string `json:"access_token"`
+	TokenType   string `json:"token_type"`
+	ExpiresIn   int    `json:"expires_in"`
+}
+
+type errorRes struct {
+	Error struct {
+		Status  int    `json:"status"`
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+type TrackPage struct {
+	Items  []Track `json:"items"`
+	Total  int     `json:"total"`
+	Limit  int     `json:"limit"`
+	Offset int     `json:"offset"`
+}
+
+type ArtistPage struct {
+	Items  []Artist `json:"items"`
+	Total  int      `json:"total"`
+	Limit  int      `json:"limit"`
+	Offset int      `json:"offset"`
+}
+
+type AlbumPage struct {
+	Items  []Album `json:"items"`
+	Total  int     `json:"total"`
+	Limit  int     `json:"limit"`
+	Offset int     `json:"offset"`
+}
+
+// SearchResult only has the pages for the types that were asked for
+type SearchResult struct {
+	Tracks  *TrackPage  `json:"tracks,omitempty"`
+	Artists *ArtistPage `json:"artists,omitempty"`
+	Albums  *AlbumPage  `json:"albums,omitempty"`
+}
@@ -0,0 +1,225 @@
+package spotify
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/BearTS/go-gin-monolith/config"
+	"github.com/pkg/errors"
+)
+
+const (
+	apiURL      = "https://api.spotify.com/v1"
+	accountsURL = "https://accounts.spotify.com/api/token"
+
+	// per request limits of the multi-ID endpoints
+	MaxTracksPerRequest  = 50
+	MaxArtistsPerRequest = 50
+	MaxAlbumsPerRequest  = 20
+	MaxSearchLimit       = 50
+)
+
+type Interface interface {
+	GetTracks(ctx context.Context, ids []string) ([]Track, error)
+	GetArtists(ctx context.Context, ids []string) ([]Artist, error)
+	GetAlbums(ctx context.Context, ids []string) ([]Album, error)
+	Search(ctx context.Context, query string, types []string, market string, limit int, offset int) (SearchResult, error)
+}
+
+// APIError is a non 2xx answer from Spotify
+type APIError struct {
+	StatusCode int
+	Message    string
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("spotify: %d %s", e.StatusCode, e.Message)
+}
+
+// Client talks to the Web API with app credentials (client credentials flow),
+// so it can read the public catalog but nothing tied to a user
+type Client struct {
+	httpClient   *http.Client
+	clientID     string
+	clientSecret string
+
+	mu          sync.Mutex
+	accessToken string
+	expiresAt   time.Time
+}
+
+func NewClient() *Client {
+	return &Client{
+		httpClient:   &http.Client{Timeout: 10 * time.Second},
+		clientID:     config.Spotify.ClientID,
+		clientSecret: config.Spotify.ClientSecret,
+	}
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                   Catalog                                  */
+/* -------------------------------------------------------------------------- */
+
+// GetTracks fetches up to MaxTracksPerRequest tracks, unknown IDs are skipped
+func (s *Client) GetTracks(ctx context.Context, ids []string) ([]Track, error) {
+	var res tracksRes
+	var tracks []Track
+
+	if len(ids) > MaxTracksPerRequest {
+		return tracks, errors.New("[GetTracks] too many ids")
+	}
+
+	err := s.get(ctx, "/tracks", url.Values{"ids": {strings.Join(ids, ",")}}, &res)
+	if err != nil {
+		return tracks, errors.Wrap(err, "[GetTracks]")
+	}
+
+	for _, track := range res.Tracks {
+		if track != nil {
+			tracks = append(tracks, *track)
+		}
+	}
+	return tracks, nil
+}
+
+// GetArtists fetches up to MaxArtistsPerRequest artists, unknown IDs are skipped
+func (s *Client) GetArtists(ctx context.Context, ids []string) ([]Artist, error) {
+	var res artistsRes
+	var artists []Artist
+
+	if len(ids) > MaxArtistsPerRequest {
+		return artists, errors.New("[GetArtists] too many ids")
+	}
+
+	err := s.get(ctx, "/artists", url.Values{"ids": {strings.Join(ids, ",")}}, &res)
+	if err != nil {
+		return artists, errors.Wrap(err, "[GetArtists]")
+	}
+
+	for _, artist := range res.Artists {
+		if artist != nil {
+			artists = append(artists, *artist)
+		}
+	}
+	return artists, nil
+}
+
+// GetAlbums fetches up to MaxAlbumsPerRequest albums, unknown IDs are skipped
+func (s *Client) GetAlbums(ctx context.Context, ids []string) ([]Album, error) {
+	var res albumsRes
+	var albums []Album
+
+	if len(ids) > MaxAlbumsPerRequest {
+		return albums, errors.New("[GetAlbums] too many ids")
+	}
+
+	err := s.get(ctx, "/albums", url.Values{"ids": {strings.Join(ids, ",")}}, &res)
+	if err != nil {
+		return albums, errors.Wrap(err, "[GetAlbums]")
+	}
+
+	for _, album := range res.Albums {
+		if album != nil {
+			albums = append(albums, *album)
+		}
+	}
+	return albums, nil
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                   Search                                   */
+/* -------------------------------------------------------------------------- */
+
+// Search runs a catalog search, types are any of track, artist and album
+func (s *Client) Search(ctx context.Context, query string, types []string, market string, limit int, offset int) (SearchResult, error) {
+	var res SearchResult
+
+	if limit <= 0 || limit > MaxSearchLimit {
+		return res, errors.New("[Search] invalid limit")
+	}
+
+	params := url.Values{
+		"q":      {query},
+		"type":   {strings.Join(types, ",")},
+		"limit":  {strconv.Itoa(limit)},
+		"offset": {strconv.Itoa(offset)},
+	}
+	if market != "" {
+		params.Set("market", market)
+	}
+
+	err := s.get(ctx, "/search", params, &res)
+	if err != nil {
+		return res, errors.Wrap(err, "[Search]")
+	}
+	return res, nil
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                  Transport                                 */
+/* -------------------------------------------------------------------------- */
+
+func (s *Client) get(ctx context.Context, path string, query url.Values, out interface{}) error {
+	token, err := s.token(ctx)
+	if err != nil {
+		return errors.Wrap(err, "[get][token]")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, apiURL+path+"?"+query.Encode(), nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	return s.do(req, out)
+}
+
+func (s *Client) do(req *http.Request, out interface{}) error {
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		var errRes errorRes
+		_ = json.NewDecoder(resp.Body).Decode(&errRes)
+		return &APIError{StatusCode: resp.StatusCode, Message: errRes.Error.Message}
+	}
+
+	return json.NewDecoder(resp.Body).Decode(out)
+}
+
+// token returns a cached app access token, refreshing it a minute before expiry
+func (s *Client) token(ctx context.Context) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.accessToken != "" && time.Now().Before(s.expiresAt) {
+		return s.accessToken, nil
+	}
+
+	form := url.Values{"grant_type": {"client_credentials"}}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, accountsURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", err
+	}
+	req.SetBasicAuth(s.clientID, s.clientSecret)
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	var res tokenRes
+	if err := s.do(req, &res); err != nil {
+		return "", err
+	}
+
+	s.accessToken = res.AccessToken
+	s.expiresAt = time.Now().Add(time.Duration(res.ExpiresIn)*time.Second - time.Minute)
+	return s.accessToken, nil
+}
@@ -0,0 +1,55 @@
+package utils
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+/* -------------------------------------------------------------------------- */
+/*                          ETag from a row version                           */
+/* -------------------------------------------------------------------------- */
+
+// IfMatchAny is the version GetIfMatchVersion returns for `If-Match: *`, which
+// matches whatever version the resource is at as long as it exists
+const IfMatchAny = 0
+
+var (
+	ErrIfMatchMissing = errors.New("If-Match header is required")
+	ErrIfMatchInvalid = errors.New("invalid If-Match header")
+	// ErrIfMatchWeak is a precondition failure, If-Match uses strong comparison
+	// so a weak tag never matches (RFC 7232 section 3.1)
+	ErrIfMatchWeak = errors.New("weak ETags never match If-Match")
+)
+
+// SetETag exposes a row version to the client as a strong ETag
+func SetETag(ctx *gin.Context, version int) {
+	ctx.Header("ETag", `"`+strconv.Itoa(version)+`"`)
+}
+
+// GetIfMatchVersion reads back the version a client sent in If-Match.
+// Only a single strong tag, or *, makes sense for a version.
+func GetIfMatchVersion(ctx *gin.Context) (int, error) {
+	header := strings.TrimSpace(ctx.GetHeader("If-Match"))
+	if header == "" {
+		return 0, ErrIfMatchMissing
+	}
+	if header == "*" {
+		return IfMatchAny, nil
+	}
+	if strings.HasPrefix(header, "W/") {
+		return 0, ErrIfMatchWeak
+	}
+
+	if len(header) < 2 || header[0] != '"' || header[len(header)-1] != '"' {
+		return 0, ErrIfMatchInvalid
+	}
+
+	version, err := strconv.Atoi(header[1 : len(header)-1])
+	if err != nil || version < 1 {
+		return 0, ErrIfMatchInvalid
+	}
+	return version, nil
+}
@@ -0,0 +1,54 @@
+package utils
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func ifMatchContext(header string) *gin.Context {
+	ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+	ctx.Request = httptest.NewRequest(http.MethodPatch, "/", nil)
+	if header != "" {
+		ctx.Request.Header.Set("If-Match", header)
+	}
+	return ctx
+}
+
+func TestSetETag(t *testing.T) {
+	recorder := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(recorder)
+
+	SetETag(ctx, 7)
+	assert.Equal(t, `"7"`, recorder.Header().Get("ETag"))
+}
+
+func TestGetIfMatchVersion(t *testing.T) {
+	cases := []struct {
+		header  string
+		version int
+		err     error
+	}{
+		{`"7"`, 7, nil},
+		{` "12" `, 12, nil},
+		{`*`, IfMatchAny, nil},
+		{``, 0, ErrIfMatchMissing},
+		{`W/"7"`, 0, ErrIfMatchWeak},
+		{`7`, 0, ErrIfMatchInvalid},
+		{`"0"`, 0, ErrIfMatchInvalid},
+		{`"abc"`, 0, ErrIfMatchInvalid},
+		{`"7", "8"`, 0, ErrIfMatchInvalid},
+	}
+	for _, tc := range cases {
+		version, err := GetIfMatchVersion(ifMatchContext(tc.header))
+		if tc.err != nil {
+			assert.ErrorIs(t, err, tc.err, tc.header)
+			continue
+		}
+		assert.NoError(t, err, tc.header)
+		assert.Equal(t, tc.version, version, tc.header)
+	}
+}
@@ -0,0 +1,151 @@
+package utils
+
+import (
+	"errors"
+	"strings"
+)
+
+/* -------------------------------------------------------------------------- */
+/*                          Fractional rank keys                              */
+/* -------------------------------------------------------------------------- */
+
+// Rank keys order items (playlist tracks, queue entries) by plain string
+// comparison, so moving an item rewrites only that item's key. A key is the
+// fractional part of a base 36 number: "i" sits at 0.5, "0i" at 0.0138.
+// Store them in a column compared byte by byte (COLLATE "C").
+const rankDigits = "0123456789abcdefghijklmnopqrstuvwxyz"
+
+// RankRebalanceLength is the key length past which a list should be rebalanced
+const RankRebalanceLength = 24
+
+var (
+	ErrInvalidRankKey = errors.New("invalid rank key")
+	// ErrRankCollision means two neighbours share a key, which happens when
+	// two users drop items on the same spot at once. Rebalance the list.
+	ErrRankCollision = errors.New("rank keys collide, rebalance the list")
+)
+
+// RankBetween returns a key that sorts strictly between before and after.
+// An empty before means the start of the list, an empty after the end.
+// It is deterministic: the same neighbours always produce the same key.
+func RankBetween(before string, after string) (string, error) {
+	if !validRankKey(before) || !validRankKey(after) {
+		return "", ErrInvalidRankKey
+	}
+	if before != "" && after != "" {
+		if before == after {
+			return "", ErrRankCollision
+		}
+		if before > after {
+			return "", ErrInvalidRankKey
+		}
+	}
+	return rankMidpoint(before, after, after == ""), nil
+}
+
+// RankKeys returns n evenly spaced keys, as short as possible, for seeding or
+// rebalancing a list in its current order
+func RankKeys(n int) []string {
+	keys := make([]string, 0, n)
+	if n <= 0 {
+		return keys
+	}
+
+	// smallest length with room for n keys and a gap on either side
+	length := 1
+	space := int64(len(rankDigits))
+	for space <= int64(n) {
+		length++
+		space *= int64(len(rankDigits))
+	}
+
+	for i := 1; i <= n; i++ {
+		value := int64(i) * space / int64(n+1)
+		keys = append(keys, encodeRank(value, length))
+	}
+	return keys
+}
+
+// NeedsRebalance reports whether a key has grown long enough that its list
+// should be given fresh keys with RankKeys
+func NeedsRebalance(key string) bool {
+	return len(key) > RankRebalanceLength
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                   Helpers                                  */
+/* -------------------------------------------------------------------------- */
+
+// rankMidpoint finds a key between a and b, treating a missing digit of a as 0
+// and, when open is set, b as 1.0
+func rankMidpoint(a string, b string, open bool) string {
+	if !open {
+		// carry over the common prefix
+		n := 0
+		for n < len(b) && rankDigitAt(a, n) == b[n] {
+			n++
+		}
+		if n > 0 {
+			return b[:n] + rankMidpoint(trimPrefix(a, n), b[n:], false)
+		}
+	}
+
+	digitA := 0
+	if a != "" {
+		digitA = strings.IndexByte(rankDigits, a[0])
+	}
+	digitB := len(rankDigits)
+	if !open {
+		digitB = strings.IndexByte(rankDigits, b[0])
+	}
+
+	if digitB-digitA > 1 {
+		return string(rankDigits[(digitA+digitB+1)/2])
+	}
+
+	// the first digits are neighbours
+	if !open && len(b) > 1 {
+		return b[:1]
+	}
+	return string(rankDigits[digitA]) + rankMidpoint(trimPrefix(a, 1), "", true)
+}
+
+func rankDigitAt(key string, i int) byte {
+	if i < len(key) {
+		return key[i]
+	}
+	return rankDigits[0]
+}
+
+func trimPrefix(key string, n int) string {
+	if n >= len(key) {
+		return ""
+	}
+	return key[n:]
+}
+
+func encodeRank(value int64, length int) string {
+	b := make([]byte, length)
+	for i := length - 1; i >= 0; i-- {
+		b[i] = rankDigits[value%int64(len(rankDigits))]
+		value /= int64(len(rankDigits))
+	}
+	// trailing zeros add nothing to the value and would leave no room before the key
+	return strings.TrimRight(string(b), rankDigits[:1])
+}
+
+// keys never end in 0, otherwise nothing could be placed right before them
+func validRankKey(key string) bool {
+	if key == "" {
+		return true
+	}
+	if key[len(key)-1] == rankDigits[0] {
+		return false
+	}
+	for i := 0; i < len(key); i++ {
+		if strings.IndexByte(rankDigits, key[i]) < 0 {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,126 @@
+package utils
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRankBetween(t *testing.T) {
+	cases := []struct {
+		before string
+		after  string
+	}{
+		{"", ""},
+		{"", "i"},
+		{"i", ""},
+		{"i", "j"},
+		{"a", "a1"},
+		{"", "01"},
+		{"z", ""},
+		{"zz", ""},
+		{"abc", "abd"},
+	}
+	for _, tc := range cases {
+		key, err := RankBetween(tc.before, tc.after)
+		require.NoError(t, err, tc)
+		if tc.before != "" {
+			assert.Greater(t, key, tc.before, tc)
+		}
+		if tc.after != "" {
+			assert.Less(t, key, tc.after, tc)
+		}
+		assert.True(t, validRankKey(key), key)
+	}
+}
+
+func TestRankBetweenRejectsBadInput(t *testing.T) {
+	_, err := RankBetween("b", "a")
+	assert.ErrorIs(t, err, ErrInvalidRankKey)
+
+	_, err = RankBetween("a0", "")
+	assert.ErrorIs(t, err, ErrInvalidRankKey)
+
+	_, err = RankBetween("A", "")
+	assert.ErrorIs(t, err, ErrInvalidRankKey)
+
+	_, err = RankBetween("i", "i")
+	assert.ErrorIs(t, err, ErrRankCollision)
+}
+
+func TestRankBetweenRepeatedInserts(t *testing.T) {
+	// keep inserting right after the first item, the worst case for key growth
+	low, high := "", ""
+	for i := 0; i < 500; i++ {
+		key, err := RankBetween(low, high)
+		require.NoError(t, err)
+		if low != "" {
+			require.Greater(t, key, low)
+		}
+		if high != "" {
+			require.Less(t, key, high)
+		}
+		high = key
+	}
+	assert.True(t, NeedsRebalance(high))
+}
+
+func TestRankKeys(t *testing.T) {
+	for _, n := range []int{0, 1, 2, 35, 36, 1000} {
+		keys := RankKeys(n)
+		require.Len(t, keys, n)
+		assert.True(t, sort.StringsAreSorted(keys), n)
+		for i, key := range keys {
+			assert.True(t, validRankKey(key) && key != "", key)
+			assert.False(t, NeedsRebalance(key))
+			if i > 0 {
+				assert.NotEqual(t, keys[i-1], key)
+			}
+		}
+	}
+
+	// there is room around every key after a rebalance
+	keys := RankKeys(10)
+	_, err := RankBetween("", keys[0])
+	assert.NoError(t, err)
+	_, err = RankBetween(keys[9], "")
+	assert.NoError(t, err)
+}
+
+func TestRankConcurrentMovesConverge(t *testing.T) {
+	type item struct {
+		pid  string
+		rank string
+	}
+	keys := RankKeys(3)
+
+	// two users drop different items between the same neighbours at the same
+	// time, each replica applies the moves in its own order
+	apply := func(moves []string) []string {
+		items := []item{{"a", keys[0]}, {"b", keys[1]}, {"c", keys[2]}, {"x", "z"}, {"y", "zz"}}
+		for _, pid := range moves {
+			rank, err := RankBetween(keys[0], keys[1])
+			require.NoError(t, err)
+			for i := range items {
+				if items[i].pid == pid {
+					items[i].rank = rank
+				}
+			}
+		}
+		sort.Slice(items, func(i, j int) bool {
+			if items[i].rank != items[j].rank {
+				return items[i].rank < items[j].rank
+			}
+			return items[i].pid < items[j].pid
+		})
+		order := make([]string, 0, len(items))
+		for _, it := range items {
+			order = append(order, it.pid)
+		}
+		return order
+	}
+
+	assert.Equal(t, apply([]string{"x", "y"}), apply([]string{"y", "x"}))
+}